@@ -0,0 +1,153 @@
+// Package answers stores the accepted answer for every solved part, so the
+// solutions can be checked against their real inputs after refactoring.
+package answers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+// DefaultPath is where answers are stored, relative to the module root.
+const DefaultPath = "answers.json"
+
+// Store maps year, day and part to the accepted answer.
+type Store map[int]map[int]map[int]string
+
+// Load reads the store at path. A missing file is an empty store.
+func Load(path string) (Store, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Store{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	store := Store{}
+	if err := json.Unmarshal(data, &store); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return store, nil
+}
+
+// Save writes the store to path.
+func (s Store) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Get returns the accepted answer for one part of a day.
+func (s Store) Get(year, day, part int) (string, bool) {
+	answer, ok := s[year][day][part]
+	return answer, ok
+}
+
+// Set records the accepted answer for one part of a day.
+func (s Store) Set(year, day, part int, answer string) {
+	if s[year] == nil {
+		s[year] = map[int]map[int]string{}
+	}
+
+	if s[year][day] == nil {
+		s[year][day] = map[int]string{}
+	}
+
+	s[year][day][part] = answer
+}
+
+// Status describes how a solver's output compares with the store.
+type Status string
+
+const (
+	Match    Status = "ok"
+	Mismatch Status = "mismatch"
+	Missing  Status = "no answer"
+	NoInput  Status = "no input"
+	Failed   Status = "error"
+)
+
+// Result is the outcome of checking one part.
+type Result struct {
+	Day    int
+	Part   int
+	Want   string
+	Got    string
+	Status Status
+	Err    error
+}
+
+// Check runs one part of d on its real input, opened through inputs, and
+// compares the output with the accepted answer. Inputs that are missing, or
+// encrypted without a key at hand, are reported as NoInput.
+func (s Store) Check(ctx context.Context, inputs *input.Resolver, d registry.Day, part int) (Result, error) {
+	result := Result{Day: d.Day, Part: part}
+
+	solver, err := d.Part(part)
+	if err != nil {
+		return result, err
+	}
+
+	want, ok := s.Get(registry.Year, d.Day, part)
+	if !ok {
+		result.Status = Missing
+		return result, nil
+	}
+	result.Want = want
+
+	in, err := inputs.Open(d)
+	if errors.Is(err, fs.ErrNotExist) || errors.Is(err, input.ErrNoKey) {
+		result.Status = NoInput
+		return result, nil
+	}
+	if err != nil {
+		result.Status = Failed
+		result.Err = err
+		return result, nil
+	}
+	defer in.Close()
+
+	got, err := solver(ctx, in)
+	if err != nil {
+		result.Status = Failed
+		result.Err = err
+		return result, nil
+	}
+
+	result.Got = strconv.Itoa(got)
+	result.Status = Match
+	if result.Got != want {
+		result.Status = Mismatch
+	}
+
+	return result, nil
+}
+
+// Verify checks every solved part of days.
+func (s Store) Verify(ctx context.Context, inputs *input.Resolver, days []registry.Day) []Result {
+	results := []Result{}
+
+	for _, d := range days {
+		for part := 1; part <= 2; part++ {
+			result, err := s.Check(ctx, inputs, d, part)
+			if err != nil {
+				continue
+			}
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
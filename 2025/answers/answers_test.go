@@ -0,0 +1,90 @@
+package answers
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+
+	store, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading missing store: %v", err)
+	}
+
+	store.Set(2025, 1, 1, "1165")
+	store.Set(2025, 1, 2, "6496")
+
+	if err := store.Save(path); err != nil {
+		t.Fatalf("failed to save store: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("failed to load store: %v", err)
+	}
+
+	if got, ok := loaded.Get(2025, 1, 2); !ok || got != "6496" {
+		t.Errorf("got %q, %v want %q", got, ok, "6496")
+	}
+
+	if _, ok := loaded.Get(2024, 1, 1); ok {
+		t.Error("expected no answer for another year")
+	}
+}
+
+func TestStore_Verify(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "day01"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "day01", "input"), []byte("x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	days := []registry.Day{
+		{
+			Day:     1,
+			PartOne: func(context.Context, io.Reader) (int, error) { return 7, nil },
+			PartTwo: func(context.Context, io.Reader) (int, error) { return 8, nil },
+		},
+		{
+			Day:     2,
+			PartOne: func(context.Context, io.Reader) (int, error) { return 1, nil },
+		},
+		{
+			Day:     3,
+			PartOne: func(context.Context, io.Reader) (int, error) { return 1, nil },
+		},
+	}
+
+	store := Store{}
+	store.Set(registry.Year, 1, 1, "7")
+	store.Set(registry.Year, 1, 2, "9")
+	store.Set(registry.Year, 2, 1, "1")
+
+	got := store.Verify(t.Context(), input.New(root), days)
+	want := []Result{
+		{Day: 1, Part: 1, Want: "7", Got: "7", Status: Match},
+		{Day: 1, Part: 2, Want: "9", Got: "8", Status: Mismatch},
+		{Day: 2, Part: 1, Want: "1", Status: NoInput},
+		{Day: 3, Part: 1, Status: Missing},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("result %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Package aoctest runs table-driven tests of a day's solutions. A day declares
+// its cases, each an input, a part and the expected answer, and Run turns them
+// into subtests.
+package aoctest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// DefaultFile is the input a case reads when it sets neither File nor Input.
+const DefaultFile = "test_input"
+
+// Options carries the settings a solver takes besides its input, such as the
+// number of pairs day08 connects in the example.
+type Options map[string]int
+
+// Int returns the option called name, or fallback when it is not set.
+func (o Options) Int(name string, fallback int) int {
+	if value, ok := o[name]; ok {
+		return value
+	}
+	return fallback
+}
+
+// Solver solves one part of a puzzle with a case's options.
+type Solver func(ctx context.Context, input io.Reader, opts Options) (int, error)
+
+// Plain adapts a solver that takes no options, like the RunPartOne and
+// RunPartTwo functions of most days.
+func Plain[N int | int64](part func(ctx context.Context, input io.Reader) (N, error)) Solver {
+	if part == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, input io.Reader, _ Options) (int, error) {
+		answer, err := part(ctx, input)
+		return int(answer), err
+	}
+}
+
+// Parts holds a day's solvers. Cases for a nil part are skipped.
+type Parts struct {
+	One Solver
+	Two Solver
+}
+
+// Case is one expected answer.
+type Case struct {
+	// Name labels the subtest; it defaults to the input file or "inline".
+	Name string
+	// File is the input file, relative to the package directory. It defaults
+	// to test_input when Input is empty.
+	File string
+	// Input is the input itself. A leading newline is dropped so it can be
+	// written as a raw string starting on its own line.
+	Input string
+	// Part is 1 or 2.
+	Part int
+	// Want is the expected answer, unless WantErr is set.
+	Want int
+	// WantErr expects the solver to reject the input.
+	WantErr bool
+	// Options are passed to the solver.
+	Options Options
+	// Skip, when set, skips the case with Skip as the reason.
+	Skip string
+}
+
+// name returns the subtest name, such as "PartOne/test_input".
+func (c Case) name() string {
+	part := fmt.Sprintf("Part%d", c.Part)
+	switch c.Part {
+	case 1:
+		part = "PartOne"
+	case 2:
+		part = "PartTwo"
+	}
+
+	label := c.Name
+	switch {
+	case label != "":
+	case c.Input != "":
+		label = "inline"
+	default:
+		label = c.file()
+	}
+
+	return part + "/" + label
+}
+
+func (c Case) file() string {
+	if c.File == "" {
+		return DefaultFile
+	}
+	return c.File
+}
+
+// open returns the case's input.
+func (c Case) open() (io.ReadCloser, error) {
+	if c.Input != "" {
+		return io.NopCloser(strings.NewReader(strings.TrimPrefix(c.Input, "\n"))), nil
+	}
+	return os.Open(c.file())
+}
+
+// solver returns the solver for the case's part, or an error when the part
+// does not exist.
+func (p Parts) solver(part int) (Solver, error) {
+	switch part {
+	case 1:
+		return p.One, nil
+	case 2:
+		return p.Two, nil
+	}
+	return nil, fmt.Errorf("part %d: want 1 or 2", part)
+}
+
+// Run runs every case as a subtest of t. Skipped cases, including those for a
+// part with no solver yet, are listed at the end so unfinished parts are not
+// forgotten.
+func Run(t *testing.T, parts Parts, cases []Case) {
+	t.Helper()
+
+	var skipped []string
+
+	for _, c := range cases {
+		solve, err := parts.solver(c.Part)
+		if err != nil {
+			t.Errorf("%s: %v", c.name(), err)
+			continue
+		}
+
+		reason := c.Skip
+		if reason == "" && solve == nil {
+			reason = "no solver"
+		}
+
+		if reason != "" {
+			skipped = append(skipped, fmt.Sprintf("%s: %s", c.name(), reason))
+		}
+
+		t.Run(c.name(), func(t *testing.T) {
+			if reason != "" {
+				t.Skip(reason)
+			}
+
+			input, err := c.open()
+			if err != nil {
+				t.Fatalf("failed to open input: %v", err)
+			}
+			defer input.Close()
+
+			got, err := solve(t.Context(), input, c.Options)
+			if c.WantErr {
+				if err == nil {
+					t.Errorf("got %d, want an error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != c.Want {
+				t.Error(diff(got, c.Want))
+			}
+		})
+	}
+
+	if len(skipped) > 0 {
+		t.Logf("skipped %d of %d cases:\n\t%s", len(skipped), len(cases), strings.Join(skipped, "\n\t"))
+	}
+}
+
+// diff describes a wrong answer, including how far off it is, which is what
+// the puzzle page hints at after a wrong submission.
+func diff(got, want int) string {
+	switch {
+	case got > want:
+		return fmt.Sprintf("got %d want %d (%d too high)", got, want, got-want)
+	case got < want:
+		return fmt.Sprintf("got %d want %d (%d too low)", got, want, want-got)
+	}
+	return fmt.Sprintf("got %d want %d", got, want)
+}
@@ -0,0 +1,98 @@
+package aoctest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// countLines answers with the number of lines, taking an offset option, and
+// rejects inputs containing "bad".
+func countLines(_ context.Context, input io.Reader, opts Options) (int, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return 0, err
+	}
+
+	if strings.Contains(string(data), "bad") {
+		return 0, errors.New("bad input")
+	}
+
+	return strings.Count(string(data), "\n") + opts.Int("offset", 0), nil
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "three"), []byte("a\nb\nc\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	Run(t, Parts{One: countLines}, []Case{
+		{File: "three", Part: 1, Want: 3},
+		{Input: `
+a
+b
+`, Part: 1, Want: 2},
+		{Name: "offset", Input: "a\n", Part: 1, Want: 11, Options: Options{"offset": 10}},
+		{Input: "bad\n", Part: 1, WantErr: true},
+		{Part: 2, Want: 1},
+		{Part: 1, Want: 1, Skip: "not solved yet"},
+	})
+}
+
+func TestPlain(t *testing.T) {
+	wide := func(context.Context, io.Reader) (int64, error) { return 1 << 40, nil }
+
+	got, err := Plain(wide)(t.Context(), nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != 1<<40 {
+		t.Errorf("got %d want %d", got, 1<<40)
+	}
+
+	var missing func(context.Context, io.Reader) (int, error)
+	if Plain(missing) != nil {
+		t.Error("expected a nil part to stay nil")
+	}
+}
+
+func TestCase_Name(t *testing.T) {
+	tests := []struct {
+		c    Case
+		want string
+	}{
+		{Case{Part: 1}, "PartOne/test_input"},
+		{Case{Part: 2, File: "larger_input"}, "PartTwo/larger_input"},
+		{Case{Part: 1, Input: "1\n"}, "PartOne/inline"},
+		{Case{Part: 2, Name: "empty", Input: "1\n"}, "PartTwo/empty"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.c.name(); got != tt.want {
+			t.Errorf("got %q want %q", got, tt.want)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		got, want int
+		diff      string
+	}{
+		{45, 40, "got 45 want 40 (5 too high)"},
+		{38, 40, "got 38 want 40 (2 too low)"},
+	}
+
+	for _, tt := range tests {
+		if got := diff(tt.got, tt.want); got != tt.diff {
+			t.Errorf("got %q want %q", got, tt.diff)
+		}
+	}
+}
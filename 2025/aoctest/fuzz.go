@@ -0,0 +1,97 @@
+package aoctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"time"
+)
+
+// FuzzTimeout bounds each fuzz run, since valid inputs can describe huge
+// puzzles. A solver must return soon after it is cancelled.
+const FuzzTimeout = 100 * time.Millisecond
+
+// hangGrace is how long past FuzzTimeout a solver may take to notice that it
+// was cancelled before it counts as hung.
+const hangGrace = 2 * time.Second
+
+// Fuzz fuzzes one part of a day, seeded with the example in test_input, the
+// empty input and blank lines. Bad input must come back as an error: the
+// part must not panic or ignore cancellation, and an input it accepts must
+// give the same answer when solved again.
+func Fuzz(f *testing.F, part Solver, opts Options) {
+	f.Helper()
+
+	if part == nil {
+		f.Skip("no solver")
+	}
+
+	example, err := os.ReadFile(DefaultFile)
+	if err != nil {
+		f.Fatalf("failed to read example: %v", err)
+	}
+
+	f.Add(string(example))
+	f.Add("")
+	f.Add("\n\n")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		first, timedOut, err := solveOnce(t, part, input, opts)
+		if err != nil || timedOut {
+			return
+		}
+
+		second, timedOut, err := solveOnce(t, part, input, opts)
+		switch {
+		case timedOut:
+		case err != nil:
+			t.Errorf("got %d, then error %v on the same input", first, err)
+		case second != first:
+			t.Errorf("got %d, then %d on the same input", first, second)
+		}
+	})
+}
+
+// solveOnce runs part on input with FuzzTimeout, reporting whether it ran out
+// of time, and fails t when the part does not return after being cancelled.
+func solveOnce(t *testing.T, part Solver, input string, opts Options) (answer int, timedOut bool, err error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(t.Context(), FuzzTimeout)
+	defer cancel()
+
+	type result struct {
+		answer int
+		err    error
+		panic  string
+	}
+	done := make(chan result, 1)
+
+	// The part runs on its own goroutine so a hang can be caught, which means
+	// a panic has to be carried back to fail the test here.
+	go func() {
+		var r result
+		defer func() {
+			if p := recover(); p != nil {
+				r.panic = fmt.Sprintf("%v\n%s", p, debug.Stack())
+			}
+			done <- r
+		}()
+
+		r.answer, r.err = part(ctx, strings.NewReader(input), opts)
+	}()
+
+	select {
+	case r := <-done:
+		if r.panic != "" {
+			t.Fatalf("panic: %s", r.panic)
+		}
+		return r.answer, ctx.Err() != nil, r.err
+	case <-time.After(FuzzTimeout + hangGrace):
+		t.Fatalf("still running %s after being cancelled", hangGrace)
+		return 0, true, nil
+	}
+}
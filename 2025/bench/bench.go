@@ -0,0 +1,96 @@
+// Package bench measures the registered solutions on their real inputs.
+package bench
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+// Result is the cost of one run of a part.
+type Result struct {
+	Day         int   `json:"day"`
+	Part        int   `json:"part"`
+	N           int   `json:"n"`
+	NsPerOp     int64 `json:"ns_per_op"`
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+}
+
+// Func returns a benchmark running solver on data. The input is held in
+// memory so that reading it from disk is not measured.
+func Func(solver registry.Part, data []byte) func(*testing.B) {
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := solver(b.Context(), bytes.NewReader(data)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// SetBenchTime changes how long, or how many times ("100x"), each benchmark
+// runs. It accepts the same values as go test -benchtime.
+func SetBenchTime(benchTime string) error {
+	testing.Init()
+	return flag.Set("test.benchtime", benchTime)
+}
+
+// Run benchmarks one part of d on its real input, opened through inputs.
+func Run(inputs *input.Resolver, d registry.Day, part int) (Result, error) {
+	solver, err := d.Part(part)
+	if err != nil {
+		return Result{}, err
+	}
+
+	data, err := inputs.ReadAll(d)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if _, err := solver(context.Background(), bytes.NewReader(data)); err != nil {
+		return Result{}, fmt.Errorf("day %d part %d: %w", d.Day, part, err)
+	}
+
+	measured := testing.Benchmark(Func(solver, data))
+
+	return Result{
+		Day:         d.Day,
+		Part:        part,
+		N:           measured.N,
+		NsPerOp:     measured.NsPerOp(),
+		AllocsPerOp: measured.AllocsPerOp(),
+		BytesPerOp:  measured.AllocedBytesPerOp(),
+	}, nil
+}
+
+// WriteTable prints results as an aligned table.
+func WriteTable(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "DAY\tPART\tRUNS\tTIME/OP\tALLOCS/OP\tBYTES/OP\t")
+
+	for _, r := range results {
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%s\t%d\t%d\t\n", r.Day, r.Part, r.N, formatNs(r.NsPerOp), r.AllocsPerOp, r.BytesPerOp)
+	}
+
+	return tw.Flush()
+}
+
+// formatNs renders a duration with microsecond precision once it reaches a
+// millisecond, which is plenty to compare days.
+func formatNs(ns int64) string {
+	d := time.Duration(ns)
+	if d >= time.Millisecond {
+		d = d.Round(time.Microsecond)
+	}
+	return d.String()
+}
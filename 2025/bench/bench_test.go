@@ -0,0 +1,89 @@
+package bench
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+func TestRun(t *testing.T) {
+	if err := SetBenchTime("10x"); err != nil {
+		t.Fatalf("failed to set bench time: %v", err)
+	}
+	t.Cleanup(func() { SetBenchTime("1s") })
+
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "day01"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "day01", "input"), []byte("x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var seen string
+	d := registry.Day{
+		Day: 1,
+		PartOne: func(ctx context.Context, in io.Reader) (int, error) {
+			data, err := io.ReadAll(in)
+			seen = string(data)
+			return len(make([]byte, 64)), err
+		},
+	}
+
+	inputs := input.New(root)
+
+	result, err := Run(inputs, d, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if seen != "x\n" {
+		t.Errorf("solver got input %q", seen)
+	}
+
+	if result.Day != 1 || result.Part != 1 || result.N != 10 {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	if _, err := Run(inputs, d, 2); err == nil {
+		t.Error("expected error for unsolved part")
+	}
+
+	failing := registry.Day{
+		Day:     1,
+		PartOne: func(context.Context, io.Reader) (int, error) { return 0, errors.New("bad input") },
+	}
+
+	if _, err := Run(inputs, failing, 1); err == nil {
+		t.Error("expected error for failing solver")
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	var b strings.Builder
+
+	err := WriteTable(&b, []Result{
+		{Day: 2, Part: 1, N: 10, NsPerOp: 1500000, AllocsPerOp: 3, BytesPerOp: 128},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), b.String())
+	}
+
+	for _, field := range []string{"2", "1", "10", "1.5ms", "3", "128"} {
+		if !strings.Contains(lines[1], field) {
+			t.Errorf("row %q is missing %q", lines[1], field)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package bench
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DefaultHistoryPath is where recorded runs are appended, relative to the
+// module root.
+const DefaultHistoryPath = "bench_history.jsonl"
+
+// Record is one recorded benchmark run.
+type Record struct {
+	Commit  string    `json:"commit"`
+	Time    time.Time `json:"time"`
+	Results []Result  `json:"results"`
+}
+
+// AppendHistory adds a record as one JSON line at the end of path.
+func AppendHistory(path string, record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// LoadHistory reads every record in path, oldest first. A missing file is an
+// empty history.
+func LoadHistory(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records := []Record{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
+
+// CurrentCommit returns the abbreviated HEAD commit, marked "-dirty" when the
+// work tree has uncommitted changes, or "unknown" outside a git checkout.
+func CurrentCommit() string {
+	out, err := exec.Command("git", "rev-parse", "--short", "HEAD").Output()
+	if err != nil {
+		return "unknown"
+	}
+	commit := strings.TrimSpace(string(out))
+
+	status, err := exec.Command("git", "status", "--porcelain", "--untracked-files=no").Output()
+	if err == nil && len(strings.TrimSpace(string(status))) > 0 {
+		commit += "-dirty"
+	}
+
+	return commit
+}
+
+// Change compares one part between a baseline and the current run.
+type Change struct {
+	Day       int
+	Part      int
+	Baseline  int64
+	Current   int64
+	Percent   float64
+	Regressed bool
+}
+
+// Compare matches current results with the baseline by day and part and
+// flags every part that got more than threshold percent slower. Parts
+// missing from the baseline are skipped.
+func Compare(baseline, current []Result, threshold float64) []Change {
+	previous := map[[2]int]Result{}
+	for _, r := range baseline {
+		previous[[2]int{r.Day, r.Part}] = r
+	}
+
+	changes := []Change{}
+	for _, r := range current {
+		before, ok := previous[[2]int{r.Day, r.Part}]
+		if !ok || before.NsPerOp == 0 {
+			continue
+		}
+
+		percent := float64(r.NsPerOp-before.NsPerOp) / float64(before.NsPerOp) * 100
+		changes = append(changes, Change{
+			Day:       r.Day,
+			Part:      r.Part,
+			Baseline:  before.NsPerOp,
+			Current:   r.NsPerOp,
+			Percent:   percent,
+			Regressed: percent > threshold,
+		})
+	}
+
+	return changes
+}
+
+// WriteMarkdown summarizes a comparison against the baseline record.
+func WriteMarkdown(w io.Writer, baseline Record, changes []Change, threshold float64) error {
+	regressions := 0
+	for _, c := range changes {
+		if c.Regressed {
+			regressions++
+		}
+	}
+
+	fmt.Fprintf(w, "## Benchmark comparison\n\n")
+	fmt.Fprintf(w, "Baseline `%s` recorded %s.\n\n", baseline.Commit, baseline.Time.Format(time.DateTime))
+	fmt.Fprintf(w, "| Day | Part | Baseline | Current | Change |\n")
+	fmt.Fprintf(w, "| --: | ---: | -------: | ------: | -----: |\n")
+
+	for _, c := range changes {
+		change := fmt.Sprintf("%+.1f%%", c.Percent)
+		if c.Regressed {
+			change = "**" + change + "**"
+		}
+
+		fmt.Fprintf(w, "| %d | %d | %s | %s | %s |\n", c.Day, c.Part, formatNs(c.Baseline), formatNs(c.Current), change)
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d of %d parts got more than %g%% slower.\n", regressions, len(changes), threshold)
+	return err
+}
@@ -0,0 +1,94 @@
+package bench
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHistory_AppendAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	records, err := LoadHistory(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading missing history: %v", err)
+	}
+
+	if len(records) != 0 {
+		t.Errorf("expected empty history, got %v", records)
+	}
+
+	first := Record{Commit: "aaa", Time: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), Results: []Result{{Day: 1, Part: 1, NsPerOp: 100}}}
+	second := Record{Commit: "bbb", Time: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC), Results: []Result{{Day: 1, Part: 1, NsPerOp: 90}}}
+
+	for _, record := range []Record{first, second} {
+		if err := AppendHistory(path, record); err != nil {
+			t.Fatalf("failed to append: %v", err)
+		}
+	}
+
+	records, err = LoadHistory(path)
+	if err != nil {
+		t.Fatalf("failed to load history: %v", err)
+	}
+
+	if len(records) != 2 || records[0].Commit != "aaa" || records[1].Results[0].NsPerOp != 90 {
+		t.Errorf("unexpected history %+v", records)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := []Result{
+		{Day: 1, Part: 1, NsPerOp: 1000},
+		{Day: 2, Part: 1, NsPerOp: 1000},
+		{Day: 2, Part: 2, NsPerOp: 1000},
+	}
+	current := []Result{
+		{Day: 1, Part: 1, NsPerOp: 1050},
+		{Day: 2, Part: 1, NsPerOp: 1200},
+		{Day: 2, Part: 2, NsPerOp: 500},
+		{Day: 3, Part: 1, NsPerOp: 5000},
+	}
+
+	got := Compare(baseline, current, 10)
+	want := []Change{
+		{Day: 1, Part: 1, Baseline: 1000, Current: 1050, Percent: 5},
+		{Day: 2, Part: 1, Baseline: 1000, Current: 1200, Percent: 20, Regressed: true},
+		{Day: 2, Part: 2, Baseline: 1000, Current: 500, Percent: -50},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	var b strings.Builder
+	baseline := Record{Commit: "abc123", Time: time.Date(2025, 12, 10, 6, 0, 0, 0, time.UTC)}
+	changes := []Change{
+		{Day: 2, Part: 1, Baseline: 1000000, Current: 1200000, Percent: 20, Regressed: true},
+		{Day: 2, Part: 2, Baseline: 1000000, Current: 900000, Percent: -10},
+	}
+
+	if err := WriteMarkdown(&b, baseline, changes, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"Baseline `abc123` recorded 2025-12-10 06:00:00.",
+		"| 2 | 1 | 1ms | 1.2ms | **+20.0%** |",
+		"| 2 | 2 | 1ms | 900µs | -10.0% |",
+		"1 of 2 parts got more than 10% slower.",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("summary is missing %q:\n%s", want, b.String())
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Package client talks to the Advent of Code website, or any server that
+// mimics it, on behalf of a logged in user.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultBaseURL is the Advent of Code website.
+const DefaultBaseURL = "https://adventofcode.com"
+
+// UserAgent identifies the tool to the Advent of Code maintainers, as asked
+// for in their automation guidelines.
+const UserAgent = "github.com/myty/advent-of-code/2025"
+
+// Client sends authenticated requests to an Advent of Code server.
+type Client struct {
+	BaseURL string
+	Session string
+	HTTP    *http.Client
+}
+
+// New returns a client for baseURL authenticated with the session cookie.
+func New(baseURL, session string) *Client {
+	return &Client{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Session: session,
+		HTTP:    http.DefaultClient,
+	}
+}
+
+// Submit posts an answer for one part of a day and returns the response page.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (string, error) {
+	form := url.Values{}
+	form.Set("level", strconv.Itoa(part))
+	form.Set("answer", answer)
+
+	endpoint := fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, year, day)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return c.do(req)
+}
+
+// Puzzle fetches the page of a day's puzzle. Once part one is solved the page
+// also holds part two and the accepted answers.
+func (c *Client) Puzzle(ctx context.Context, year, day int) (string, error) {
+	endpoint := fmt.Sprintf("%s/%d/day/%d", c.BaseURL, year, day)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return c.do(req)
+}
+
+// Leaderboard fetches the JSON of a private leaderboard, whose id is the
+// number in its URL. The site asks that this is done at most once every 15
+// minutes.
+func (c *Client) Leaderboard(ctx context.Context, year, id int) (string, error) {
+	endpoint := fmt.Sprintf("%s/%d/leaderboard/private/view/%d.json", c.BaseURL, year, id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return c.do(req)
+}
+
+func (c *Client) do(req *http.Request) (string, error) {
+	req.Header.Set("User-Agent", UserAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s %s: %s", req.Method, req.URL, resp.Status)
+	}
+
+	return string(body), nil
+}
+
+// ErrNoSession is returned by Session when no session cookie is configured.
+var ErrNoSession = errors.New("no session: set AOC_SESSION or write the cookie to ~/.adventofcode.session")
+
+// Session returns the session cookie from the AOC_SESSION environment
+// variable, falling back to ~/.adventofcode.session as used by aoc-cli.
+func Session() (string, error) {
+	if session := os.Getenv("AOC_SESSION"); session != "" {
+		return session, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", ErrNoSession
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".adventofcode.session"))
+	if err != nil {
+		return "", ErrNoSession
+	}
+
+	session := strings.TrimSpace(string(data))
+	if session == "" {
+		return "", ErrNoSession
+	}
+
+	return session, nil
+}
+
+// BaseURL returns the server to talk to: AOC_ENDPOINT when set, otherwise
+// the Advent of Code website.
+func BaseURL() string {
+	if endpoint := os.Getenv("AOC_ENDPOINT"); endpoint != "" {
+		return endpoint
+	}
+	return DefaultBaseURL
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSubmit_PostsAnswer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2025/day/3/answer" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("missing session cookie: %v", err)
+		}
+
+		if got := r.Header.Get("User-Agent"); got != UserAgent {
+			t.Errorf("got user agent %q, want %q", got, UserAgent)
+		}
+
+		if got := r.PostFormValue("level"); got != "2" {
+			t.Errorf("got level %q, want %q", got, "2")
+		}
+
+		if got := r.PostFormValue("answer"); got != "42" {
+			t.Errorf("got answer %q, want %q", got, "42")
+		}
+
+		w.Write([]byte("<article><p>That's the right answer!</p></article>"))
+	}))
+	defer server.Close()
+
+	body, err := New(server.URL+"/", "secret").Submit(t.Context(), 2025, 3, 2, "42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body != "<article><p>That's the right answer!</p></article>" {
+		t.Errorf("unexpected body %q", body)
+	}
+}
+
+func TestSubmit_StatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Please log in", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	_, err := New(server.URL, "").Submit(t.Context(), 2025, 1, 1, "1")
+	if err == nil {
+		t.Error("expected error for non-200 response")
+	}
+}
+
+func TestPuzzle_GetsPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/2025/day/7" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("missing session cookie: %v", err)
+		}
+
+		w.Write([]byte("<main><article class=\"day-desc\"></article></main>"))
+	}))
+	defer server.Close()
+
+	body, err := New(server.URL, "secret").Puzzle(t.Context(), 2025, 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body != `<main><article class="day-desc"></article></main>` {
+		t.Errorf("unexpected body %q", body)
+	}
+}
+
+func TestLeaderboard_GetsJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/2025/leaderboard/private/view/1234.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("missing session cookie: %v", err)
+		}
+
+		w.Write([]byte(`{"event":"2025","members":{}}`))
+	}))
+	defer server.Close()
+
+	body, err := New(server.URL, "secret").Leaderboard(t.Context(), 2025, 1234)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body != `{"event":"2025","members":{}}` {
+		t.Errorf("unexpected body %q", body)
+	}
+}
+
+func TestSession_Environment(t *testing.T) {
+	t.Setenv("AOC_SESSION", "from-env")
+
+	got, err := Session()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != "from-env" {
+		t.Errorf("got %q, want %q", got, "from-env")
+	}
+}
+
+func TestSession_File(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("AOC_SESSION", "")
+	t.Setenv("HOME", home)
+
+	if _, err := Session(); err != ErrNoSession {
+		t.Errorf("got %v, want ErrNoSession", err)
+	}
+
+	err := os.WriteFile(filepath.Join(home, ".adventofcode.session"), []byte("from-file\n"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write session file: %v", err)
+	}
+
+	got, err := Session()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != "from-file" {
+		t.Errorf("got %q, want %q", got, "from-file")
+	}
+}
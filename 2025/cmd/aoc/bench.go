@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"aoc/2025/bench"
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+func runBench(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	day := flags.Int("day", 0, "only benchmark the given day")
+	part := flags.Int("part", 0, "only benchmark the given part")
+	benchTime := flags.String("benchtime", "1s", "run time or count (e.g. 100x) per part")
+	record := flags.Bool("record", false, "append the results to the history file")
+	compare := flags.Bool("compare", false, "compare with the last recorded run and print a Markdown summary")
+	threshold := flags.Float64("threshold", 10, "percentage slowdown reported as a regression by -compare")
+	historyPath := flags.String("history", "", "benchmark history file (default <root>/"+bench.DefaultHistoryPath+")")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if err := bench.SetBenchTime(*benchTime); err != nil {
+		return err
+	}
+
+	resolver, err := input.Default("")
+	if err != nil {
+		return err
+	}
+
+	if *historyPath, err = rootFile(*historyPath, bench.DefaultHistoryPath); err != nil {
+		return err
+	}
+
+	days := registry.All()
+	if *day != 0 {
+		d, err := lookupDay(*day)
+		if err != nil {
+			return err
+		}
+		days = []registry.Day{d}
+	}
+
+	results := []bench.Result{}
+	for _, d := range days {
+		for p := 1; p <= 2; p++ {
+			if *part != 0 && p != *part {
+				continue
+			}
+
+			if _, err := d.Part(p); err != nil {
+				fmt.Fprintf(os.Stderr, "Skipped: %v\n", err)
+				continue
+			}
+
+			result, err := bench.Run(resolver, d, p)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+	}
+
+	if len(results) == 0 {
+		return errors.New("no solved parts to benchmark")
+	}
+
+	if !*compare {
+		if err := bench.WriteTable(os.Stdout, results); err != nil {
+			return err
+		}
+	}
+
+	regressions := 0
+	if *compare {
+		history, err := bench.LoadHistory(*historyPath)
+		if err != nil {
+			return err
+		}
+
+		if len(history) == 0 {
+			return fmt.Errorf("no baseline in %s; run with -record first", *historyPath)
+		}
+
+		baseline := history[len(history)-1]
+		changes := bench.Compare(baseline.Results, results, *threshold)
+		if err := bench.WriteMarkdown(os.Stdout, baseline, changes, *threshold); err != nil {
+			return err
+		}
+
+		for _, c := range changes {
+			if c.Regressed {
+				regressions++
+			}
+		}
+	}
+
+	if *record {
+		err := bench.AppendHistory(*historyPath, bench.Record{
+			Commit:  bench.CurrentCommit(),
+			Time:    time.Now().UTC(),
+			Results: results,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if regressions > 0 {
+		return fmt.Errorf("%d part(s) regressed by more than %g%%", regressions, *threshold)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"aoc/2025/day08"
+	"aoc/2025/viz"
+)
+
+func runCircuits(args []string) error {
+	flags := flag.NewFlagSet("circuits", flag.ContinueOnError)
+	top := flags.Int("top", 1000, "number of closest pairs to connect")
+	output := flags.String("o", "", "file to write: .ply, .obj or .html for a self-contained viewer")
+	inputPath := inputFlag(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return errors.New("missing -o")
+	}
+
+	write, err := cloudWriter(*output)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	d, err := lookupDay(8)
+	if err != nil {
+		return err
+	}
+
+	resolver, err := inputs(*inputPath)
+	if err != nil {
+		return err
+	}
+
+	in, err := resolver.Open(d)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	cloud, err := day08.Export(ctx, in, *top)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := write(cloud, f); err != nil {
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote %s: %s\n", *output, cloud.Title)
+	return nil
+}
+
+// cloudWriter picks the format from the output file's extension.
+func cloudWriter(path string) (func(c *viz.Cloud, f *os.File) error, error) {
+	switch filepath.Ext(path) {
+	case ".ply":
+		return func(c *viz.Cloud, f *os.File) error { return c.WritePLY(f) }, nil
+	case ".obj":
+		return func(c *viz.Cloud, f *os.File) error { return c.WriteOBJ(f) }, nil
+	case ".html":
+		return func(c *viz.Cloud, f *os.File) error { return c.WriteHTML(f) }, nil
+	}
+	return nil, fmt.Errorf("unknown format %q: want .ply, .obj or .html", filepath.Ext(path))
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	_ "aoc/2025/days"
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+// rootFile returns the path of a file kept at the module root, such as
+// answers.json, unless a flag gave the path explicitly.
+func rootFile(flagValue, name string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+
+	root, err := input.Root()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, name), nil
+}
+
+// dayDir returns the directory holding the given day's solution under the
+// module root.
+func dayDir(day int) (string, error) {
+	root, err := input.Root()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, fmt.Sprintf("day%02d", day)), nil
+}
+
+// dayDirs returns every dayNN directory under the module root, or only the
+// requested day's.
+func dayDirs(day int) ([]string, error) {
+	if day != 0 {
+		dir, err := dayDir(day)
+		if err != nil {
+			return nil, err
+		}
+		return []string{dir}, nil
+	}
+
+	root, err := input.Root()
+	if err != nil {
+		return nil, err
+	}
+	return filepath.Glob(filepath.Join(root, "day[0-9][0-9]"))
+}
+
+// lookupDay returns the registered solution for the -day flag.
+func lookupDay(day int) (registry.Day, error) {
+	if day == 0 {
+		return registry.Day{}, errors.New("missing -day")
+	}
+
+	d, ok := registry.Lookup(day)
+	if !ok {
+		return registry.Day{}, fmt.Errorf("day %d is not registered", day)
+	}
+
+	return d, nil
+}
+
+// inputFlag registers the -input flag of the commands that run a solver.
+func inputFlag(flags *flag.FlagSet) *string {
+	return flags.String("input", "", "file to solve instead of dayNN/input, or - for stdin (default $"+input.OverrideEnv+")")
+}
+
+// inputs returns the resolver for the module root, replacing every day's
+// input with the -input flag or $AOC_INPUT when set.
+func inputs(override string) (*input.Resolver, error) {
+	return input.Default(cmp.Or(override, os.Getenv(input.OverrideEnv)))
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestRootFile(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("AOC_ROOT", root)
+
+	got, err := rootFile("", "answers.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := filepath.Join(root, "answers.json"); got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+
+	got, err = rootFile("elsewhere.json", "answers.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "elsewhere.json" {
+		t.Errorf("got %q want %q", got, "elsewhere.json")
+	}
+}
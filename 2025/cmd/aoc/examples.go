@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"aoc/2025/puzzle"
+)
+
+func runExamples(args []string) error {
+	flags := flag.NewFlagSet("examples", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to extract examples for")
+	write := flags.Bool("write", false, "write test_input and fill the placeholder wants in solution_test.go")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *day == 0 {
+		return errors.New("missing -day")
+	}
+
+	dir, err := dayDir(*day)
+	if err != nil {
+		return err
+	}
+
+	examples, err := readExamples(dir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s/test_input:\n%s\n\n", dir, examples.Input)
+	fmt.Println("Part one:", orUnknown(examples.Answers[0]))
+	fmt.Println("Part two:", orUnknown(examples.Answers[1]))
+
+	if !*write {
+		return nil
+	}
+
+	if examples.Input != "" {
+		path := filepath.Join(dir, "test_input")
+		if err := os.WriteFile(path, []byte(examples.Input+"\n"), 0644); err != nil {
+			return err
+		}
+		fmt.Println("Wrote", path)
+	}
+
+	path := filepath.Join(dir, "solution_test.go")
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	filled := fillWants(string(source), examples.Answers)
+	if filled == string(source) {
+		return nil
+	}
+
+	if err := os.WriteFile(path, []byte(filled), 0644); err != nil {
+		return err
+	}
+	fmt.Println("Wrote", path)
+
+	return nil
+}
+
+// readExamples prefers the cached puzzle page and falls back to puzzle.md.
+func readExamples(dir string) (puzzle.Examples, error) {
+	page, err := os.ReadFile(filepath.Join(dir, "puzzle.html"))
+	if err == nil {
+		return puzzle.ExamplesFromHTML(page)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return puzzle.Examples{}, err
+	}
+
+	doc, err := os.ReadFile(filepath.Join(dir, "puzzle.md"))
+	if err != nil {
+		return puzzle.Examples{}, err
+	}
+
+	return puzzle.ExamplesFromMarkdown(doc), nil
+}
+
+// fillWants replaces the `Want: -1` placeholders in the cases left by
+// dayTemplate with the example answers and drops their Skip, so the tests fail
+// until the solver produces the example answer.
+func fillWants(source string, answers [2]string) string {
+	for part, answer := range answers {
+		if _, err := strconv.ParseInt(answer, 10, 64); err != nil {
+			continue
+		}
+
+		placeholder := regexp.MustCompile(fmt.Sprintf(`(?m)\{Part: %d, Want: -1(.*?)(?:, Skip: "[^"]*")?\},$`, part+1))
+		source = placeholder.ReplaceAllString(source, fmt.Sprintf("{Part: %d, Want: %s${1}},", part+1, answer))
+	}
+
+	return source
+}
+
+func orUnknown(answer string) string {
+	if answer == "" {
+		return "(not found)"
+	}
+	return answer
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const templateTest = `package daytemplate
+
+import (
+	"testing"
+
+	"aoc/2025/aoctest"
+)
+
+var cases = []aoctest.Case{
+	{Part: 1, Want: -1, Skip: "template"},
+	{Part: 2, Want: -1, Options: aoctest.Options{"top": 10}, Skip: "template"},
+}
+
+func TestSolution(t *testing.T) {
+	aoctest.Run(t, aoctest.Parts{
+		One: aoctest.Plain(RunPartOne),
+		Two: aoctest.Plain(RunPartTwo),
+	}, cases)
+}
+`
+
+func TestFillWants_BothParts(t *testing.T) {
+	got := fillWants(templateTest, [2]string{"3", "6"})
+
+	want := `package daytemplate
+
+import (
+	"testing"
+
+	"aoc/2025/aoctest"
+)
+
+var cases = []aoctest.Case{
+	{Part: 1, Want: 3},
+	{Part: 2, Want: 6, Options: aoctest.Options{"top": 10}},
+}
+
+func TestSolution(t *testing.T) {
+	aoctest.Run(t, aoctest.Parts{
+		One: aoctest.Plain(RunPartOne),
+		Two: aoctest.Plain(RunPartTwo),
+	}, cases)
+}
+`
+
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFillWants_MissingAnswer(t *testing.T) {
+	got := fillWants(templateTest, [2]string{"3", ""})
+
+	if !strings.Contains(got, "{Part: 1, Want: 3},") {
+		t.Errorf("part one placeholder was not filled:\n%s", got)
+	}
+
+	if !strings.Contains(got, `{Part: 2, Want: -1, Options: aoctest.Options{"top": 10}, Skip: "template"},`) {
+		t.Errorf("part two placeholder should be kept:\n%s", got)
+	}
+}
+
+func TestFillWants_AlreadyFilled(t *testing.T) {
+	filled := fillWants(templateTest, [2]string{"3", "6"})
+	got := fillWants(filled, [2]string{"4", "7"})
+
+	if got != filled {
+		t.Errorf("filled wants should not be overwritten:\n%s", got)
+	}
+}
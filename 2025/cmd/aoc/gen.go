@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+)
+
+func runGen(args []string) error {
+	flags := flag.NewFlagSet("gen", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to generate an input for")
+	size := flags.Int("size", 100, "number of lines, ranges or grid rows, depending on the day")
+	seed := flags.Uint64("seed", 0, "random seed (0 picks one and prints it)")
+	output := flags.String("o", "", "file to write instead of stdout")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *size <= 0 {
+		return fmt.Errorf("-size must be positive, got %d", *size)
+	}
+
+	d, err := lookupDay(*day)
+	if err != nil {
+		return err
+	}
+
+	if d.Generate == nil {
+		return fmt.Errorf("day %d has no input generator", d.Day)
+	}
+
+	if *seed == 0 {
+		*seed = rand.Uint64()
+		fmt.Fprintf(os.Stderr, "seed %d\n", *seed)
+	}
+
+	generated := d.Generate(rand.New(rand.NewPCG(*seed, 0)), *size)
+
+	if *output == "" {
+		_, err := os.Stdout.WriteString(generated)
+		return err
+	}
+
+	return os.WriteFile(*output, []byte(generated), 0o644)
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunGen_Size(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "input")
+
+	for _, size := range []string{"0", "-1"} {
+		if err := runGen([]string{"-day", "2", "-size", size, "-seed", "1", "-o", output}); err == nil {
+			t.Errorf("-size %s: expected error", size)
+		}
+	}
+
+	if err := runGen([]string{"-day", "2", "-size", "3", "-seed", "1", "-o", output}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info, err := os.Stat(output); err != nil || info.Size() == 0 {
+		t.Errorf("expected a generated input, got %v", err)
+	}
+}
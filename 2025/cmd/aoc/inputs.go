@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"aoc/2025/input"
+)
+
+func runInputs(args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing subcommand: encrypt, decrypt or keygen")
+	}
+
+	defaultKey, _ := input.DefaultKeyPath()
+
+	flags := flag.NewFlagSet("inputs "+args[0], flag.ContinueOnError)
+	keyPath := flags.String("key", defaultKey, "key file (AOC_INPUT_KEY)")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "keygen":
+		return keygen(*keyPath)
+	case "encrypt":
+		return transformInputs(*keyPath, flags.Args(), input.Encrypt)
+	case "decrypt":
+		return transformInputs(*keyPath, flags.Args(), input.Decrypt)
+	default:
+		return fmt.Errorf("unknown subcommand %q", args[0])
+	}
+}
+
+// keygen writes a new key, refusing to replace an existing one since inputs
+// encrypted with it could no longer be read.
+func keygen(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%s already exists", path)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, []byte(input.GenerateKey()+"\n"), 0o600); err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote %s. Keep a copy somewhere safe; inputs cannot be decrypted without it.\n", path)
+	return nil
+}
+
+// transformInputs rewrites the given files in place, or copies stdin to
+// stdout when there are none, which is how git runs clean and smudge filters.
+func transformInputs(keyPath string, files []string, transform func(key, data []byte) ([]byte, error)) error {
+	key, err := input.LoadKey(keyPath)
+	if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		out, err := transform(key, data)
+		if err != nil {
+			return err
+		}
+
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+
+	for _, path := range files {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		out, err := transform(key, data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		if err := os.WriteFile(path, out, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
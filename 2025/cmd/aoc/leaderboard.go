@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"aoc/2025/client"
+	"aoc/2025/leaderboard"
+	"aoc/2025/registry"
+)
+
+func runLeaderboard(args []string) error {
+	flags := flag.NewFlagSet("leaderboard", flag.ContinueOnError)
+	id := flags.Int("id", 0, "private leaderboard to fetch, the number in its URL")
+	file := flags.String("file", "", "read a saved leaderboard JSON instead of fetching one (- for stdin)")
+	save := flags.String("save", "", "also write the fetched JSON to this file, for -file later")
+	year := flags.Int("year", registry.Year, "event year")
+	endpoint := flags.String("endpoint", client.BaseURL(), "server to fetch from (AOC_ENDPOINT)")
+	width := flags.Int("width", 40, "length of the longest chart bar")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *width <= 0 {
+		return fmt.Errorf("-width must be positive, got %d", *width)
+	}
+
+	var r io.Reader
+	switch {
+	case *file == "-":
+		r = os.Stdin
+	case *file != "":
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	case *id != 0:
+		session, err := client.Session()
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		body, err := client.New(*endpoint, session).Leaderboard(ctx, *year, *id)
+		if err != nil {
+			return err
+		}
+
+		if *save != "" {
+			if err := os.WriteFile(*save, []byte(body), 0644); err != nil {
+				return err
+			}
+		}
+		r = strings.NewReader(body)
+	default:
+		return errors.New("missing -id or -file")
+	}
+
+	lb, err := leaderboard.Parse(r)
+	if err != nil {
+		return err
+	}
+
+	return leaderboard.WriteReport(os.Stdout, lb, *width)
+}
@@ -0,0 +1,74 @@
+// Command aoc bundles the tooling used alongside the 2025 solutions.
+//
+// Run it from the 2025 directory:
+//
+//	go run ./cmd/aoc <command> [flags]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+)
+
+type command struct {
+	Name    string
+	Summary string
+	Run     func(args []string) error
+}
+
+func commands() []command {
+	return []command{
+		{Name: "bench", Summary: "benchmark solutions on their real inputs", Run: runBench},
+		{Name: "circuits", Summary: "export day 8's junction boxes and circuits in 3D", Run: runCircuits},
+		{Name: "examples", Summary: "propose test_input and example answers from the puzzle text", Run: runExamples},
+		{Name: "gen", Summary: "generate a random input for a day", Run: runGen},
+		{Name: "inputs", Summary: "encrypt or decrypt puzzle inputs (encrypt, decrypt, keygen)", Run: runInputs},
+		{Name: "leaderboard", Summary: "show standings, charts and part two times of a private leaderboard", Run: runLeaderboard},
+		{Name: "puzzle", Summary: "regenerate puzzle.md files from cached puzzle.html pages", Run: runPuzzle},
+		{Name: "run", Summary: "run a day's solution against its input", Run: runRun},
+		{Name: "serve", Summary: "serve a dashboard of days, answers, timings and puzzles", Run: runServe},
+		{Name: "stats", Summary: "report solve times, rejected answers, lines and coverage per day", Run: runStats},
+		{Name: "submit", Summary: "submit a day's answer and record the verdict", Run: runSubmit},
+		{Name: "trace", Summary: "list or count the events recorded by run -events", Run: runTrace},
+		{Name: "verify", Summary: "check every solution against its accepted answer", Run: runVerify},
+		{Name: "watch", Summary: "re-run a day's solution and example tests on changes", Run: runWatch},
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	for _, c := range commands() {
+		if c.Name != name {
+			continue
+		}
+
+		if err := c.Run(os.Args[2:]); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				os.Exit(2)
+			}
+			fmt.Fprintf(os.Stderr, "aoc %s: %v\n", name, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n\n", name)
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: aoc <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+	for _, c := range commands() {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", c.Name, c.Summary)
+	}
+}
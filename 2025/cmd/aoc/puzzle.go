@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"aoc/2025/client"
+	"aoc/2025/puzzle"
+	"aoc/2025/registry"
+)
+
+func runPuzzle(args []string) error {
+	flags := flag.NewFlagSet("puzzle", flag.ContinueOnError)
+	day := flags.Int("day", 0, "only regenerate the given day")
+	refresh := flags.Bool("refresh", false, "fetch puzzle.html again even when it is cached, e.g. to add part two")
+	endpoint := flags.String("endpoint", client.BaseURL(), "server to fetch puzzle pages from (AOC_ENDPOINT)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	dirs, err := dayDirs(*day)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	f := &pageFetcher{endpoint: *endpoint}
+	for _, dir := range dirs {
+		page, err := f.page(ctx, dir, *refresh)
+		if err != nil {
+			return err
+		}
+
+		markdown, err := puzzle.Markdown(page)
+		if err != nil {
+			return fmt.Errorf("%s: %w", dir, err)
+		}
+
+		path := filepath.Join(dir, "puzzle.md")
+		if err := os.WriteFile(path, []byte(markdown), 0644); err != nil {
+			return err
+		}
+
+		fmt.Println("Wrote", path)
+	}
+
+	return nil
+}
+
+// pageFetcher returns the cached puzzle.html of a day, fetching and caching
+// it when it is missing.
+type pageFetcher struct {
+	endpoint string
+	client   *client.Client
+}
+
+func (f *pageFetcher) page(ctx context.Context, dir string, refresh bool) ([]byte, error) {
+	path := filepath.Join(dir, "puzzle.html")
+
+	if !refresh {
+		page, err := os.ReadFile(path)
+		if !errors.Is(err, fs.ErrNotExist) {
+			return page, err
+		}
+	}
+
+	day, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "day"))
+	if err != nil {
+		return nil, fmt.Errorf("%s: not a day directory", dir)
+	}
+
+	if f.client == nil {
+		session, err := client.Session()
+		if err != nil {
+			return nil, fmt.Errorf("%s: cannot fetch puzzle.html: %w", dir, err)
+		}
+		f.client = client.New(f.endpoint, session)
+	}
+
+	page, err := f.client.Puzzle(ctx, registry.Year, day)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", dir, err)
+	}
+
+	if err := os.WriteFile(path, []byte(page), 0644); err != nil {
+		return nil, err
+	}
+	fmt.Println("Fetched", path)
+
+	return []byte(page), nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPageFetcher(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/2025/day/3" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("fetched"))
+	}))
+	defer server.Close()
+
+	t.Setenv("AOC_SESSION", "secret")
+	dir := filepath.Join(t.TempDir(), "day03")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f := &pageFetcher{endpoint: server.URL}
+
+	// A missing page is fetched and cached, then read from the cache.
+	for range 2 {
+		page, err := f.page(t.Context(), dir, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(page) != "fetched" {
+			t.Errorf("got page %q want %q", page, "fetched")
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("got %d requests want 1", requests)
+	}
+
+	if _, err := f.page(t.Context(), dir, true); err != nil || requests != 2 {
+		t.Errorf("refresh: got %d requests and %v want 2 and no error", requests, err)
+	}
+}
+
+func TestPageFetcher_NoPage(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	t.Setenv("AOC_SESSION", "secret")
+	dir := filepath.Join(t.TempDir(), "day04")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f := &pageFetcher{endpoint: server.URL}
+	if _, err := f.page(t.Context(), dir, false); err == nil {
+		t.Error("expected an error when the page cannot be fetched")
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "puzzle.html")); err == nil {
+		t.Error("a failed fetch must not leave a cached page")
+	}
+}
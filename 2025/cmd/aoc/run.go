@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"time"
+
+	"aoc/2025/registry"
+	"aoc/2025/runner"
+	"aoc/2025/trace"
+	"aoc/2025/viz"
+)
+
+var partNames = map[int]string{1: "First", 2: "Second"}
+
+func runRun(args []string) (err error) {
+	flags := flag.NewFlagSet("run", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to run")
+	part := flags.Int("part", 0, "only run the given part")
+	all := flags.Bool("all", false, "run every registered day and print a timing table")
+	workers := flags.Int("workers", runtime.NumCPU(), "parts solved concurrently with -all")
+	timeout := flags.Duration("timeout", time.Minute, "time limit per part (0 for none)")
+	maxHeap := flags.Uint64("maxheap", 0, "abort a part once the heap exceeds this many MiB (0 for none)")
+	format := flags.String("format", "text", "output format: text, json or ndjson")
+	animate := flags.Bool("viz", false, "animate the days that draw their simulation in the terminal")
+	fps := flags.Int("fps", 10, "frames per second with -viz and -gif")
+	gifPath := flags.String("gif", "", "write the frames a day draws to this animated GIF")
+	svgPath := flags.String("svg", "", "write the scene a day draws to this SVG file")
+	eventsPath := flags.String("events", "", "record the solvers' trace events to this JSONL file")
+	cpuProfile := flags.Bool("cpuprofile", false, "write a CPU profile of each part to -profiledir")
+	memProfile := flags.Bool("memprofile", false, "write an allocation profile of each part to -profiledir")
+	execTrace := flags.Bool("trace", false, "write an execution trace of each part to -profiledir, for go tool trace")
+	profileDir := flags.String("profiledir", ".", "directory for the profiles, named like day08-part1.cpu.pprof")
+	top := flags.Int("top", 0, "print the N hottest functions of each profile with go tool pprof")
+	inputPath := inputFlag(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	switch *format {
+	case "text", "json", "ndjson":
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	resolver, err := inputs(*inputPath)
+	if err != nil {
+		return err
+	}
+
+	if *all && resolver.Override != "" {
+		return errors.New("-input and $AOC_INPUT need a single -day")
+	}
+
+	opts := runner.Options{Inputs: resolver, Workers: *workers, Timeout: *timeout, MaxHeap: *maxHeap << 20}
+
+	if *format == "json" || *format == "ndjson" {
+		// Allocations and the peak heap are read for the whole process, so
+		// a part's figures would include the parts running beside it.
+		opts.Workers = 1
+	}
+
+	var hooks []viz.Hook
+	if *animate {
+		hooks = append(hooks, viz.NewTerminal(os.Stdout, *fps).Draw)
+	}
+
+	recorder := &viz.Recorder{}
+	if *gifPath != "" {
+		hooks = append(hooks, recorder.Record)
+	}
+
+	if len(hooks) > 0 || *svgPath != "" {
+		if *all {
+			return errors.New("-viz, -gif and -svg need a single -day")
+		}
+
+		// Parts share the terminal and the recording, so run them one after
+		// the other.
+		opts.Workers = 1
+	}
+
+	if len(hooks) > 0 {
+		ctx = viz.WithHook(ctx, func(title string, g viz.Grid) {
+			for _, hook := range hooks {
+				hook(title, g)
+			}
+		})
+	}
+
+	var scene *viz.Scene
+	if *svgPath != "" {
+		ctx = viz.WithScene(ctx, func(s *viz.Scene) { scene = s })
+	}
+
+	days := registry.All()
+	if !*all {
+		d, err := lookupDay(*day)
+		if err != nil {
+			return err
+		}
+
+		if *part != 0 {
+			if _, err := d.Part(*part); err != nil {
+				return err
+			}
+		}
+
+		days = []registry.Day{d}
+	}
+
+	opts.Profile = runner.Profile{Dir: *profileDir, CPU: *cpuProfile, Mem: *memProfile, Trace: *execTrace}
+	if opts.Profile.Enabled() {
+		if err := os.MkdirAll(*profileDir, 0o755); err != nil {
+			return err
+		}
+
+		// Profiles cover the whole process, so keep parts apart.
+		opts.Workers = 1
+	}
+
+	if *eventsPath != "" {
+		f, err := os.Create(*eventsPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		// Keep each part's events together.
+		opts.Workers = 1
+
+		stopTrace := trace.Start(f)
+		defer func() {
+			if stopErr := errors.Join(stopTrace(), f.Close()); err == nil {
+				err = stopErr
+			}
+		}()
+	}
+
+	start := time.Now()
+	results := runner.Run(ctx, runner.Jobs(days, *part), opts)
+
+	if opts.Profile.Enabled() {
+		if err := reportProfiles(ctx, results, *top); err != nil {
+			return err
+		}
+	}
+
+	if *gifPath != "" {
+		if err := writeGIF(*gifPath, recorder, *fps); err != nil {
+			return err
+		}
+	}
+
+	if *svgPath != "" {
+		if err := writeSVG(*svgPath, scene); err != nil {
+			return err
+		}
+	}
+
+	switch *format {
+	case "json":
+		if err := runner.WriteJSON(os.Stdout, results); err != nil {
+			return err
+		}
+	case "ndjson":
+		if err := runner.WriteNDJSON(os.Stdout, results); err != nil {
+			return err
+		}
+	case "text":
+		if !*all {
+			return printResults(results)
+		}
+
+		if err := runner.WriteTable(os.Stdout, results); err != nil {
+			return err
+		}
+		fmt.Printf("\nWall time: %s\n", time.Since(start).Round(time.Microsecond))
+	}
+
+	if failed := runner.Failed(results); failed > 0 {
+		return fmt.Errorf("%d part(s) failed", failed)
+	}
+	return nil
+}
+
+// printResults prints the answers of a single day in the original free-text
+// form, stopping at the first failed part.
+func printResults(results []runner.Result) error {
+	for _, result := range results {
+		if result.Err != nil {
+			return fmt.Errorf("day %d part %d: %w", result.Day, result.Part, result.Err)
+		}
+
+		fmt.Printf("Day %d - %s submission result: %d\n", result.Day, partNames[result.Part], result.Answer)
+	}
+
+	return nil
+}
+
+// writeGIF writes the recorded frames to path.
+func writeGIF(path string, recorder *viz.Recorder, fps int) error {
+	if len(recorder.Frames) == 0 {
+		return errors.New("-gif: the day draws no frames")
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := viz.WriteGIF(f, recorder.Frames, viz.DefaultPalette, 4, time.Second/time.Duration(max(fps, 1))); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// writeSVG writes the last scene drawn to path.
+func writeSVG(path string, scene *viz.Scene) error {
+	if scene == nil {
+		return errors.New("-svg: the day draws no scene")
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := scene.WriteSVG(f); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// reportProfiles lists the profiles written for results on stderr, followed by
+// the top hottest functions of each CPU and allocation profile.
+func reportProfiles(ctx context.Context, results []runner.Result, top int) error {
+	kinds := []struct {
+		name  string
+		pprof []string
+	}{
+		{"cpu", nil},
+		{"mem", []string{"-sample_index=alloc_space"}},
+		{"trace", nil},
+	}
+
+	for _, result := range results {
+		for _, kind := range kinds {
+			// Parts that failed before solving, such as on a missing input,
+			// wrote no profile.
+			path, ok := result.Profiles[kind.name]
+			if !ok {
+				continue
+			}
+			fmt.Fprintln(os.Stderr, "Wrote", path)
+
+			if top <= 0 || kind.name == "trace" {
+				continue
+			}
+
+			args := append([]string{"tool", "pprof", "-top", fmt.Sprintf("-nodecount=%d", top)}, kind.pprof...)
+			cmd := exec.CommandContext(ctx, "go", append(args, path)...)
+			cmd.Stdout = os.Stderr
+			cmd.Stderr = os.Stderr
+			if err := cmd.Run(); err != nil {
+				return fmt.Errorf("go tool pprof %s: %w", path, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"aoc/2025/answers"
+	"aoc/2025/input"
+	"aoc/2025/registry"
+	"aoc/2025/stats"
+	"aoc/2025/submit"
+)
+
+func runStats(args []string) error {
+	flags := flag.NewFlagSet("stats", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to report (default all)")
+	answersPath := flags.String("answers", "", "file holding the accepted answers (default <root>/"+answers.DefaultPath+")")
+	ledgerPath := flags.String("ledger", "", "file recording submissions (default <root>/"+submit.DefaultPath+")")
+	cover := flags.Bool("cover", true, "measure test coverage with go test -cover")
+	output := flags.String("o", "", "write the report to this file instead of stdout")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	root, err := input.Root()
+	if err != nil {
+		return err
+	}
+
+	dirs, err := dayDirs(*day)
+	if err != nil {
+		return err
+	}
+
+	if *answersPath, err = rootFile(*answersPath, answers.DefaultPath); err != nil {
+		return err
+	}
+
+	if *ledgerPath, err = rootFile(*ledgerPath, submit.DefaultPath); err != nil {
+		return err
+	}
+
+	store, err := answers.Load(*answersPath)
+	if err != nil {
+		return err
+	}
+
+	ledger, err := submit.Load(*ledgerPath)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	coverage := map[string]float64{}
+	if *cover {
+		packages := make([]string, len(dirs))
+		for i, dir := range dirs {
+			packages[i] = "./" + filepath.Base(dir)
+		}
+
+		fmt.Fprintf(os.Stderr, "Measuring coverage of %s\n", strings.Join(packages, ", "))
+		if coverage, err = stats.Coverage(ctx, root, packages); err != nil {
+			return err
+		}
+	}
+
+	days := []stats.Day{}
+	for _, dir := range dirs {
+		number, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "day"))
+		if err != nil {
+			continue
+		}
+
+		d := stats.Day{Day: number, Coverage: -1}
+		d.Record(registry.Year, store, ledger)
+
+		if d.Commits, d.LastCommit, err = stats.GitHistory(ctx, dir); err != nil {
+			return err
+		}
+
+		if d.Lines, d.TestLines, err = stats.CountLines(dir); err != nil {
+			return err
+		}
+
+		if percent, ok := coverage[filepath.Base(dir)]; ok {
+			d.Coverage = percent
+		}
+
+		days = append(days, d)
+	}
+
+	var report bytes.Buffer
+	if err := stats.WriteMarkdown(&report, registry.Year, days); err != nil {
+		return err
+	}
+
+	if *output != "" {
+		return os.WriteFile(*output, report.Bytes(), 0644)
+	}
+
+	_, err = report.WriteTo(os.Stdout)
+	return err
+}
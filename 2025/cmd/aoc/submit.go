@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+
+	"aoc/2025/answers"
+	"aoc/2025/client"
+	"aoc/2025/registry"
+	"aoc/2025/submit"
+)
+
+func runSubmit(args []string) error {
+	flags := flag.NewFlagSet("submit", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to submit")
+	part := flags.Int("part", 0, "part to submit (1 or 2)")
+	answer := flags.String("answer", "", "answer to submit instead of running the solver")
+	endpoint := flags.String("endpoint", client.BaseURL(), "server to submit to (AOC_ENDPOINT)")
+	ledgerPath := flags.String("ledger", "", "file recording submissions (default <root>/"+submit.DefaultPath+")")
+	answersPath := flags.String("answers", "", "file holding the accepted answers (default <root>/"+answers.DefaultPath+")")
+	inputPath := inputFlag(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	d, err := lookupDay(*day)
+	if err != nil {
+		return err
+	}
+
+	if *part == 0 {
+		return errors.New("missing -part")
+	}
+
+	if *answer == "" {
+		solver, err := d.Part(*part)
+		if err != nil {
+			return err
+		}
+		resolver, err := inputs(*inputPath)
+		if err != nil {
+			return err
+		}
+		in, err := resolver.Open(d)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		result, err := solver(context.Background(), in)
+		if err != nil {
+			return err
+		}
+		*answer = strconv.Itoa(result)
+	}
+
+	session, err := client.Session()
+	if err != nil {
+		return err
+	}
+
+	if *ledgerPath, err = rootFile(*ledgerPath, submit.DefaultPath); err != nil {
+		return err
+	}
+
+	if *answersPath, err = rootFile(*answersPath, answers.DefaultPath); err != nil {
+		return err
+	}
+
+	ledger, err := submit.Load(*ledgerPath)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Printf("Submitting %s for day %d part %d\n", *answer, d.Day, *part)
+
+	response, err := submit.Submit(ctx, client.New(*endpoint, session), ledger, registry.Year, d.Day, *part, *answer)
+	if err != nil {
+		return err
+	}
+
+	if err := ledger.Save(*ledgerPath); err != nil {
+		return err
+	}
+
+	fmt.Println(response.Message)
+
+	switch response.Verdict {
+	case submit.Correct:
+		return recordAnswer(*answersPath, d.Day, *part, *answer)
+	case submit.AlreadySolved:
+		return nil
+	case submit.Wait:
+		return fmt.Errorf("wait %s before submitting again", response.Wait)
+	default:
+		return fmt.Errorf("answer %s was %s", *answer, response.Verdict)
+	}
+}
+
+// recordAnswer stores an accepted answer so verify can check it later.
+func recordAnswer(path string, day, part int, answer string) error {
+	store, err := answers.Load(path)
+	if err != nil {
+		return err
+	}
+
+	store.Set(registry.Year, day, part, answer)
+
+	return store.Save(path)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"aoc/2025/trace"
+)
+
+func runTrace(args []string) error {
+	flags := flag.NewFlagSet("trace", flag.ContinueOnError)
+	events := flags.String("event", "", "comma-separated event names to show (default all)")
+	from := flags.Int("from", 0, "first step to show")
+	to := flags.Int("to", 0, "last step to show (0 for the end)")
+	count := flags.Bool("count", false, "count the matching events by name instead of listing them")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return errors.New("usage: aoc trace [flags] <file.jsonl>")
+	}
+
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	filter := trace.Filter{From: *from, To: *to}
+	if *events != "" {
+		filter.Names = strings.Split(*events, ",")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	if *count {
+		counts := map[string]int{}
+		if err := trace.Read(f, filter, func(e trace.Entry) error {
+			counts[e.Name]++
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(w, "EVENT\tCOUNT")
+		for _, name := range slices.Sorted(maps.Keys(counts)) {
+			fmt.Fprintf(w, "%s\t%d\n", name, counts[name])
+		}
+		return w.Flush()
+	}
+
+	fmt.Fprintln(w, "STEP\tEVENT\tARGS")
+	err = trace.Read(f, filter, func(e trace.Entry) error {
+		args := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			encoded, err := json.Marshal(arg)
+			if err != nil {
+				return err
+			}
+			args[i] = string(encoded)
+		}
+
+		_, err := fmt.Fprintf(w, "%d\t%s\t%s\n", e.Step, e.Name, strings.Join(args, " "))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"aoc/2025/answers"
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+func runVerify(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	day := flags.Int("day", 0, "only verify the given day")
+	answersPath := flags.String("answers", "", "file holding the accepted answers (default <root>/"+answers.DefaultPath+")")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	resolver, err := input.Default("")
+	if err != nil {
+		return err
+	}
+
+	path, err := rootFile(*answersPath, answers.DefaultPath)
+	if err != nil {
+		return err
+	}
+
+	// Without the file every part would be skipped and verify would pass.
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("no accepted answers: %w", err)
+	}
+
+	store, err := answers.Load(path)
+	if err != nil {
+		return err
+	}
+
+	days := registry.All()
+	if *day != 0 {
+		d, err := lookupDay(*day)
+		if err != nil {
+			return err
+		}
+		days = []registry.Day{d}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DAY\tPART\tWANT\tGOT\tSTATUS")
+
+	mismatches := 0
+	for _, result := range store.Verify(context.Background(), resolver, days) {
+		status := string(result.Status)
+		if result.Err != nil {
+			status += ": " + result.Err.Error()
+		}
+
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\n", result.Day, result.Part, result.Want, result.Got, status)
+
+		if result.Status == answers.Mismatch || result.Status == answers.Failed {
+			mismatches++
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if mismatches > 0 {
+		return fmt.Errorf("%d part(s) no longer produce their accepted answer", mismatches)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc/2025/runner"
+	"aoc/2025/watch"
+)
+
+func runWatch(args []string) error {
+	flags := flag.NewFlagSet("watch", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to watch")
+	interval := flags.Duration("interval", 500*time.Millisecond, "how often files are checked for changes")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *day == 0 {
+		return errors.New("missing -day")
+	}
+
+	dir, err := dayDir(*day)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(dir); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	w := &watcher{day: *day, root: filepath.Dir(dir), answers: map[int]int{}}
+	w.check(ctx)
+
+	patterns := []string{filepath.Join(dir, "*.go"), filepath.Join(dir, "input"), filepath.Join(dir, "test_input")}
+	fmt.Printf("\nWatching %s every %s. Press Ctrl+C to stop.\n", dir, *interval)
+
+	return watch.Poll(ctx, patterns, *interval, func(changed []string) {
+		fmt.Printf("\n%s changed\n", strings.Join(changed, ", "))
+		w.check(ctx)
+	})
+}
+
+// watcher re-runs a day in a fresh process, so every check picks up the
+// latest code, and remembers the answers to report when they change.
+type watcher struct {
+	day     int
+	root    string
+	answers map[int]int
+}
+
+func (w *watcher) check(ctx context.Context) {
+	fmt.Printf("[%s] day %d\n", time.Now().Format(time.TimeOnly), w.day)
+
+	records, err := w.solve(ctx)
+	if err != nil {
+		fmt.Printf("run: %v\n", err)
+	}
+
+	for _, r := range records {
+		if r.Error != "" {
+			fmt.Printf("part %d: error: %s\n", r.Part, r.Error)
+			continue
+		}
+
+		fmt.Printf("part %d (%s): %s\n", r.Part, time.Duration(r.DurationNs).Round(time.Microsecond), w.describe(r))
+		w.answers[r.Part] = r.Answer
+	}
+
+	test := exec.CommandContext(ctx, "go", "test", fmt.Sprintf("./day%02d", w.day))
+	test.Dir = w.root
+	test.Stdout = os.Stdout
+	test.Stderr = os.Stderr
+	if err := test.Run(); err != nil && ctx.Err() == nil {
+		fmt.Println("examples: FAIL")
+	}
+}
+
+// solve builds and runs the day through `aoc run`, reading its NDJSON output.
+// A failing part makes the command exit non-zero, so the error is only
+// returned when no record was produced at all, e.g. on a build failure.
+func (w *watcher) solve(ctx context.Context) ([]runner.Record, error) {
+	var stdout bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "go", "run", "./cmd/aoc", "run", "-day", strconv.Itoa(w.day), "-format", "ndjson")
+	cmd.Dir = w.root
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	runErr := cmd.Run()
+
+	records := []runner.Record{}
+	decoder := json.NewDecoder(&stdout)
+	for {
+		var r runner.Record
+		err := decoder.Decode(&r)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return records, err
+		}
+		records = append(records, r)
+	}
+
+	if len(records) == 0 {
+		return nil, runErr
+	}
+
+	return records, nil
+}
+
+// describe formats an answer, marking how it compares to the previous run.
+func (w *watcher) describe(r runner.Record) string {
+	previous, ok := w.answers[r.Part]
+	switch {
+	case !ok:
+		return strconv.Itoa(r.Answer)
+	case previous != r.Answer:
+		return fmt.Sprintf("%d -> %d (changed)", previous, r.Answer)
+	default:
+		return fmt.Sprintf("%d (unchanged)", r.Answer)
+	}
+}
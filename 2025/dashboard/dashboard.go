@@ -0,0 +1,269 @@
+// Package dashboard serves a local web page with the state of every day: a
+// calendar of solved, partial and stub days, the accepted answers, the
+// benchmark history and each day's puzzle text and visualizations.
+package dashboard
+
+import (
+	"bytes"
+	"cmp"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc/2025/answers"
+	"aoc/2025/bench"
+	"aoc/2025/puzzle"
+	"aoc/2025/registry"
+)
+
+// Days is the number of puzzles in the 2025 calendar.
+const Days = 12
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"duration": func(ns int64) string { return time.Duration(ns).String() },
+}).ParseFS(templateFS, "templates/*.html"))
+
+// Status is how far a day or part has got.
+type Status string
+
+const (
+	// Solved parts have an accepted answer; solved days have two.
+	Solved Status = "solved"
+	// Partial days have one solved part.
+	Partial Status = "partial"
+	// Unverified parts have a solver but no accepted answer yet.
+	Unverified Status = "unverified"
+	// Stub parts have no solver yet, like a freshly copied template; stub
+	// days have no solved part.
+	Stub Status = "stub"
+	// Locked days have no registered solution.
+	Locked Status = "locked"
+)
+
+// Server serves the dashboard for the module at Root. Every page reads the
+// answers, benchmark history and puzzle files afresh, so it stays current
+// while days are solved.
+type Server struct {
+	// Root is the module root, holding the dayNN directories, answers.json
+	// and bench_history.jsonl.
+	Root string
+	// Registered lists the registered days.
+	Registered []registry.Day
+}
+
+// New returns a server for root showing the days in registry.
+func New(root string) *Server {
+	return &Server{Root: root, Registered: registry.All()}
+}
+
+// Handler routes the calendar, the day pages and the day images.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.calendar)
+	mux.HandleFunc("GET /day/{day}", s.day)
+	mux.HandleFunc("GET /day/{day}/{file}", s.image)
+	return mux
+}
+
+// Part is the state of one part of a day.
+type Part struct {
+	Part    int
+	Status  Status
+	Answer  string
+	Timings []Timing
+}
+
+// Timing is one benchmark run of a part.
+type Timing struct {
+	Commit  string
+	Time    time.Time
+	NsPerOp int64
+}
+
+// Latest returns the most recent timing, or nil when there is none.
+func (p Part) Latest() *Timing {
+	if len(p.Timings) == 0 {
+		return nil
+	}
+	return &p.Timings[len(p.Timings)-1]
+}
+
+// Sparkline returns the points of a 100×20 polyline of the part's timings,
+// slowest at the top.
+func (p Part) Sparkline() string {
+	if len(p.Timings) < 2 {
+		return ""
+	}
+
+	slowest := slices.MaxFunc(p.Timings, func(a, b Timing) int { return cmp.Compare(a.NsPerOp, b.NsPerOp) }).NsPerOp
+	points := make([]string, len(p.Timings))
+	for i, timing := range p.Timings {
+		x := float64(i) * 100 / float64(len(p.Timings)-1)
+		y := 20 - float64(timing.NsPerOp)*20/float64(max(slowest, 1))
+		points[i] = strconv.FormatFloat(x, 'f', 1, 64) + "," + strconv.FormatFloat(y, 'f', 1, 64)
+	}
+
+	return strings.Join(points, " ")
+}
+
+// Day is the state of one day of the calendar.
+type Day struct {
+	Day    int
+	Status Status
+	Parts  []Part
+	// Puzzle is the rendered puzzle.md, empty when there is none.
+	Puzzle template.HTML
+	// Images are the SVG and GIF files in the day's directory.
+	Images []string
+}
+
+// load gathers the state of every day of the calendar.
+func (s *Server) load() ([]Day, error) {
+	store, err := answers.Load(filepath.Join(s.Root, answers.DefaultPath))
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := bench.LoadHistory(filepath.Join(s.Root, bench.DefaultHistoryPath))
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]Day, Days)
+	for i := range days {
+		days[i] = Day{Day: i + 1, Status: Locked}
+	}
+
+	for _, d := range s.Registered {
+		if d.Day < 1 || d.Day > Days {
+			continue
+		}
+
+		day := Day{Day: d.Day}
+		solved := 0
+		for part := 1; part <= 2; part++ {
+			p := Part{Part: part, Status: Unverified}
+
+			if _, err := d.Part(part); err != nil {
+				p.Status = Stub
+			}
+
+			if answer, ok := store.Get(registry.Year, d.Day, part); ok {
+				p.Answer = answer
+				p.Status = Solved
+				solved++
+			}
+
+			for _, record := range history {
+				for _, result := range record.Results {
+					if result.Day == d.Day && result.Part == part {
+						p.Timings = append(p.Timings, Timing{Commit: record.Commit, Time: record.Time, NsPerOp: result.NsPerOp})
+					}
+				}
+			}
+
+			day.Parts = append(day.Parts, p)
+		}
+
+		switch solved {
+		case 2:
+			day.Status = Solved
+		case 1:
+			day.Status = Partial
+		default:
+			day.Status = Stub
+		}
+
+		days[d.Day-1] = day
+	}
+
+	return days, nil
+}
+
+func (s *Server) calendar(w http.ResponseWriter, r *http.Request) {
+	days, err := s.load()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	render(w, "calendar.html", struct {
+		Year int
+		Days []Day
+	}{registry.Year, days})
+}
+
+func (s *Server) day(w http.ResponseWriter, r *http.Request) {
+	number, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil || number < 1 || number > Days {
+		http.NotFound(w, r)
+		return
+	}
+
+	days, err := s.load()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	day := days[number-1]
+	dir := filepath.Join(s.Root, fmt.Sprintf("day%02d", number))
+
+	doc, err := os.ReadFile(filepath.Join(dir, "puzzle.md"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	day.Puzzle = template.HTML(puzzle.HTML(doc))
+
+	for _, pattern := range []string{"*.svg", "*.gif"} {
+		matches, _ := filepath.Glob(filepath.Join(dir, pattern))
+		for _, match := range matches {
+			day.Images = append(day.Images, filepath.Base(match))
+		}
+	}
+
+	render(w, "day.html", struct {
+		Year int
+		Day  Day
+	}{registry.Year, day})
+}
+
+// image serves the SVG and GIF files of a day's directory, and nothing else.
+func (s *Server) image(w http.ResponseWriter, r *http.Request) {
+	number, err := strconv.Atoi(r.PathValue("day"))
+	name := r.PathValue("file")
+	ext := filepath.Ext(name)
+
+	if err != nil || number < 1 || number > Days || name != filepath.Base(name) || (ext != ".svg" && ext != ".gif") {
+		http.NotFound(w, r)
+		return
+	}
+
+	http.ServeFile(w, r, filepath.Join(s.Root, fmt.Sprintf("day%02d", number), name))
+}
+
+// render executes a template into a buffer first, so that a failing template
+// becomes an error page rather than half a page.
+func render(w http.ResponseWriter, name string, data any) {
+	var b bytes.Buffer
+	if err := templates.ExecuteTemplate(&b, name, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	b.WriteTo(w)
+}
@@ -0,0 +1,159 @@
+package dashboard
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"aoc/2025/answers"
+	"aoc/2025/bench"
+	"aoc/2025/registry"
+)
+
+func solver(ctx context.Context, r io.Reader) (int, error) { return 0, nil }
+
+// fixture builds a module root with two registered days: day 1 with both parts
+// accepted and benchmarked, and day 2 with a stub part two.
+func fixture(t *testing.T) *httptest.Server {
+	t.Helper()
+	root := t.TempDir()
+
+	store := answers.Store{}
+	store.Set(registry.Year, 1, 1, "142")
+	store.Set(registry.Year, 1, 2, "281")
+	store.Set(registry.Year, 2, 1, "1227775554")
+	if err := store.Save(filepath.Join(root, answers.DefaultPath)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	history := filepath.Join(root, bench.DefaultHistoryPath)
+	for i, ns := range []int64{2000, 1500} {
+		record := bench.Record{Commit: []string{"aaa", "bbb"}[i], Time: time.Date(2025, 12, 1+i, 0, 0, 0, 0, time.UTC), Results: []bench.Result{{Day: 1, Part: 1, NsPerOp: ns}}}
+		if err := bench.AppendHistory(history, record); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	files := map[string]string{
+		"day01/puzzle.md":   "# Day 1: Secret Entrance\n\nThe dial starts at *50*.\n",
+		"day01/beams.svg":   `<svg xmlns="http://www.w3.org/2000/svg"></svg>`,
+		"day01/solution.go": "package day01\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	s := &Server{Root: root, Registered: []registry.Day{
+		{Day: 1, PartOne: solver, PartTwo: solver},
+		{Day: 2, PartOne: solver},
+	}}
+
+	server := httptest.NewServer(s.Handler())
+	t.Cleanup(server.Close)
+	return server
+}
+
+func get(t *testing.T, url string) (int, string) {
+	t.Helper()
+
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestServer(t *testing.T) {
+	server := fixture(t)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		want   []string
+	}{
+		{"calendar", "/", http.StatusOK, []string{
+			`<a href="/day/1" class="solved">`,
+			`<a href="/day/2" class="partial">`,
+			`<a href="/day/3" class="locked">`,
+			"part 1: 142 in 1.5µs",
+			"part 2: stub",
+		}},
+		{"solved day", "/day/1", http.StatusOK, []string{
+			"<h1>Day 1: Secret Entrance</h1>",
+			"The dial starts at <em>50</em>.",
+			"1.5µs at bbb",
+			`<polyline points="0.0,0.0 100.0,5.0"/>`,
+			`<img src="/day/1/beams.svg" alt="beams.svg">`,
+		}},
+		{"stub day", "/day/2", http.StatusOK, []string{
+			`<tr class="stub">`,
+			"No puzzle.md yet.",
+		}},
+		{"locked day", "/day/3", http.StatusOK, []string{"No solution is registered for this day yet."}},
+		{"day out of range", "/day/13", http.StatusNotFound, nil},
+		{"image", "/day/1/beams.svg", http.StatusOK, []string{"<svg"}},
+		{"missing image", "/day/2/beams.svg", http.StatusNotFound, nil},
+		{"not an image", "/day/1/solution.go", http.StatusNotFound, nil},
+		{"traversal", "/day/1/..%2fanswers.json", http.StatusNotFound, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := get(t, server.URL+tt.path)
+
+			if status != tt.status {
+				t.Fatalf("got status %d want %d", status, tt.status)
+			}
+
+			for _, want := range tt.want {
+				if !strings.Contains(body, want) {
+					t.Errorf("missing %q in:\n%s", want, body)
+				}
+			}
+		})
+	}
+}
+
+func TestPart_Sparkline(t *testing.T) {
+	tests := []struct {
+		name    string
+		timings []int64
+		want    string
+	}{
+		{"none", nil, ""},
+		{"one", []int64{10}, ""},
+		{"falling", []int64{40, 20, 10}, "0.0,0.0 50.0,10.0 100.0,15.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Part
+			for _, ns := range tt.timings {
+				p.Timings = append(p.Timings, Timing{NsPerOp: ns})
+			}
+
+			if got := p.Sparkline(); got != tt.want {
+				t.Errorf("got %q want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package day01
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// Generate returns size dial rotations of 1 to 999 clicks.
+func Generate(rng *rand.Rand, size int) string {
+	var b strings.Builder
+
+	for range size {
+		direction := "L"
+		if rng.IntN(2) == 1 {
+			direction = "R"
+		}
+		fmt.Fprintf(&b, "%s%d\n", direction, 1+rng.IntN(999))
+	}
+
+	return b.String()
+}
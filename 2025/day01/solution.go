@@ -0,0 +1,165 @@
+package day01
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"aoc/2025/registry"
+	"aoc/2025/utils"
+)
+
+func init() {
+	registry.Register(registry.Day{
+		Day:      1,
+		PartOne:  RunPartOne,
+		PartTwo:  RunPartTwo,
+		Generate: Generate,
+	})
+}
+
+func RunPartOne(ctx context.Context, input io.Reader) (int, error) {
+	count := 0
+	sum := 50
+
+	lines, errs := utils.StreamLines(ctx, input)
+	var parseErrs []error
+
+	for line := range lines {
+		if err := validateRotation(line); err != nil {
+			parseErrs = append(parseErrs, err)
+			continue
+		}
+
+		var sign string
+		if string(line[0]) == "R" {
+			sign = "+"
+		} else {
+			sign = "-"
+		}
+
+		startingIndex := getStartingIndex(line)
+		n, err := strconv.Atoi(line[startingIndex:])
+
+		if err != nil {
+			parseErrs = append(parseErrs, fmt.Errorf("parse %q: %w", line, err))
+			continue
+		}
+
+		if sign == "+" {
+			sum += n
+		} else {
+			sum -= n
+		}
+
+		if sum >= 100 {
+			sum = sum - 100
+		}
+
+		if sum < 0 {
+			sum = sum + 100
+		}
+
+		if sum == 0 {
+			count++
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// validateRotation checks that line is a direction followed by a number of
+// clicks, such as "R48".
+func validateRotation(line string) error {
+	if len(line) < 2 || (line[0] != 'L' && line[0] != 'R') {
+		return fmt.Errorf("parse %q: want L or R followed by a number of clicks", line)
+	}
+	return nil
+}
+
+func getStartingIndex(line string) int {
+	if len(line) > 3 {
+		return len(line) - 2
+	}
+
+	return 1
+}
+
+func RunPartTwo(ctx context.Context, input io.Reader) (int, error) {
+	count := 0
+	sum := 50
+
+	lines, errs := utils.StreamLines(ctx, input)
+	var parseErrs []error
+
+	for line := range lines {
+		if err := validateRotation(line); err != nil {
+			parseErrs = append(parseErrs, err)
+			continue
+		}
+
+		startingSum := sum
+		direction := string(line[0])
+		passedZeroCount := 0
+		startingIndex := getStartingIndex(line)
+		n, err := strconv.Atoi(line[startingIndex:])
+
+		if err != nil {
+			parseErrs = append(parseErrs, fmt.Errorf("parse %q: %w", line, err))
+			continue
+		}
+
+		if startingIndex > 1 {
+			passedZeroCount, err = strconv.Atoi(line[1:startingIndex])
+			if err != nil {
+				parseErrs = append(parseErrs, fmt.Errorf("parse %q: %w", line, err))
+				continue
+			}
+		}
+
+		if direction == "R" {
+			sum += n
+		} else {
+			sum -= n
+		}
+
+		if sum > 100 {
+			passedZeroCount++
+			sum = sum - 100
+		}
+
+		if sum < 0 {
+			if startingSum > 0 {
+				passedZeroCount++
+			}
+			sum = sum + 100
+		}
+
+		count += passedZeroCount
+
+		if sum == 0 || sum == 100 {
+			sum = 0
+			count++
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,35 @@
+package day02
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// maxSpan keeps generated ranges close to the real ones, which the brute
+// force solution walks ID by ID.
+const maxSpan = 200_000
+
+// Generate returns size comma separated ID ranges of up to ten digits.
+func Generate(rng *rand.Rand, size int) string {
+	ranges := make([]string, 0, size)
+
+	for range size {
+		digits := 1 + rng.IntN(10)
+		low := pow10(digits - 1)
+		start := low + rng.Int64N(9*low)
+		end := start + rng.Int64N(min(maxSpan, 9*low))
+
+		ranges = append(ranges, strconv.FormatInt(start, 10)+"-"+strconv.FormatInt(end, 10))
+	}
+
+	return strings.Join(ranges, ",") + "\n"
+}
+
+func pow10(n int) int64 {
+	result := int64(1)
+	for range n {
+		result *= 10
+	}
+	return result
+}
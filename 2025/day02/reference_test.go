@@ -0,0 +1,73 @@
+package day02
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"aoc/2025/difftest"
+	"aoc/2025/utils"
+)
+
+// reference sums the IDs of every range that invalid reports, building each
+// candidate repetition with strings.Repeat rather than comparing slices.
+func reference(invalid func(id string) bool) func(context.Context, io.Reader) (int, error) {
+	return func(ctx context.Context, input io.Reader) (int, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		total := 0
+		lines, errs := utils.StreamLines(ctx, input)
+
+		for line := range lines {
+			for ranges := range strings.SplitSeq(line, ",") {
+				start, end, _ := strings.Cut(ranges, "-")
+				first, err := strconv.Atoi(start)
+				if err != nil {
+					return 0, err
+				}
+				last, err := strconv.Atoi(end)
+				if err != nil {
+					return 0, err
+				}
+
+				for id := first; id <= last; id++ {
+					if invalid(strconv.Itoa(id)) {
+						total += id
+					}
+				}
+			}
+		}
+
+		return total, <-errs
+	}
+}
+
+func repeatedTwice(id string) bool {
+	return len(id)%2 == 0 && strings.Repeat(id[:len(id)/2], 2) == id
+}
+
+func repeatedAtLeastTwice(id string) bool {
+	for times := 2; times <= len(id); times++ {
+		if len(id)%times == 0 && strings.Repeat(id[:len(id)/times], times) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPartOne_Reference(t *testing.T) {
+	err := difftest.Check(t.Context(), reference(repeatedTwice), RunPartOne, difftest.Config{Generate: Generate, Size: 4, Runs: 10})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPartTwo_Reference(t *testing.T) {
+	err := difftest.Check(t.Context(), reference(repeatedAtLeastTwice), RunPartTwo, difftest.Config{Generate: Generate, Size: 4, Runs: 10})
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,146 @@
+package day02
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"aoc/2025/registry"
+	"aoc/2025/utils"
+)
+
+// cancelCheckInterval is how many IDs are checked between context checks.
+const cancelCheckInterval = 1 << 16
+
+func init() {
+	registry.Register(registry.Day{
+		Day:      2,
+		PartOne:  RunPartOne,
+		PartTwo:  RunPartTwo,
+		Generate: Generate,
+	})
+}
+
+func RunPartOne(ctx context.Context, input io.Reader) (int, error) {
+	total := 0
+	lines, errs := utils.StreamLines(ctx, input)
+	var parseErrs []error
+
+	for line := range lines {
+		for ranges := range strings.SplitSeq(line, ",") {
+			first, last, found := strings.Cut(ranges, "-")
+			if !found {
+				parseErrs = append(parseErrs, fmt.Errorf("parse %q: want a range such as 11-22", ranges))
+				continue
+			}
+
+			start, startErr := strconv.Atoi(first)
+			end, endErr := strconv.Atoi(last)
+
+			if err := errors.Join(startErr, endErr); err != nil {
+				parseErrs = append(parseErrs, fmt.Errorf("parse %q: %w", ranges, err))
+				continue
+			}
+
+			for i := start; i <= end; i++ {
+				if i%cancelCheckInterval == 0 {
+					if err := ctx.Err(); err != nil {
+						return 0, err
+					}
+				}
+
+				current := strconv.Itoa(i)
+
+				mid := len(current) / 2
+				if current[mid:] == current[:mid] {
+					total += i
+				}
+			}
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func RunPartTwo(ctx context.Context, input io.Reader) (int, error) {
+	total := 0
+	lines, errs := utils.StreamLines(ctx, input)
+	var parseErrs []error
+
+	for line := range lines {
+		for ranges := range strings.SplitSeq(line, ",") {
+			first, last, found := strings.Cut(ranges, "-")
+			if !found {
+				parseErrs = append(parseErrs, fmt.Errorf("parse %q: want a range such as 11-22", ranges))
+				continue
+			}
+
+			start, startErr := strconv.Atoi(first)
+			end, endErr := strconv.Atoi(last)
+
+			if err := errors.Join(startErr, endErr); err != nil {
+				parseErrs = append(parseErrs, fmt.Errorf("parse %q: %w", ranges, err))
+				continue
+			}
+
+			for i := start; i <= end; i++ {
+				if i%cancelCheckInterval == 0 {
+					if err := ctx.Err(); err != nil {
+						return 0, err
+					}
+				}
+
+				current := strconv.Itoa(i)
+				currentLength := len(current)
+
+				for groupCount := 2; groupCount <= currentLength; groupCount++ {
+					if currentLength%groupCount != 0 {
+						continue
+					}
+
+					size := currentLength / groupCount
+					start := 0
+					compare := current[start:size]
+					matchFound := false
+
+					for start += size; start < currentLength; start += size {
+						compareNext := current[start : start+size]
+
+						if compare != compareNext {
+							matchFound = false
+							break
+						}
+
+						matchFound = true
+					}
+
+					if matchFound {
+						total += i
+						break
+					}
+				}
+			}
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
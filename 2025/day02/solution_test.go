@@ -0,0 +1,25 @@
+package day02
+
+import (
+	"testing"
+
+	"aoc/2025/aoctest"
+)
+
+var cases = []aoctest.Case{
+	{Part: 1, Want: 1227775554},
+	{Part: 2, Want: 4174379265},
+}
+
+var parts = aoctest.Parts{
+	One: aoctest.Plain(RunPartOne),
+	Two: aoctest.Plain(RunPartTwo),
+}
+
+func TestSolution(t *testing.T) {
+	aoctest.Run(t, parts, cases)
+}
+
+func FuzzPartOne(f *testing.F) { aoctest.Fuzz(f, parts.One, nil) }
+
+func FuzzPartTwo(f *testing.F) { aoctest.Fuzz(f, parts.Two, nil) }
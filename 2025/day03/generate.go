@@ -0,0 +1,23 @@
+package day03
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// bankSize is the number of batteries in each bank of the real input.
+const bankSize = 100
+
+// Generate returns size banks of batteries rated 1 to 9.
+func Generate(rng *rand.Rand, size int) string {
+	var b strings.Builder
+
+	for range size {
+		for range bankSize {
+			b.WriteByte(byte('1' + rng.IntN(9)))
+		}
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package day03
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"aoc/2025/registry"
+	"aoc/2025/utils"
+)
+
+func init() {
+	registry.Register(registry.Day{
+		Day: 3,
+		PartOne: func(ctx context.Context, input io.Reader) (int, error) {
+			answer, err := RunPartOne(ctx, input)
+			return int(answer), err
+		},
+		PartTwo: func(ctx context.Context, input io.Reader) (int, error) {
+			answer, err := RunPartTwo(ctx, input)
+			return int(answer), err
+		},
+		Generate: Generate,
+	})
+}
+
+func RunPartOne(ctx context.Context, input io.Reader) (int64, error) {
+	return run(ctx, input, 2)
+}
+
+func RunPartTwo(ctx context.Context, input io.Reader) (int64, error) {
+	return run(ctx, input, 12)
+}
+
+func run(ctx context.Context, input io.Reader, indexSize int) (int64, error) {
+	total := int64(0)
+	lines, errs := utils.StreamLines(ctx, input)
+	var parseErrs []error
+
+	for line := range lines {
+		var digits []int
+		var parseErr error
+
+		for _, ch := range line {
+			num, err := strconv.Atoi(string(ch))
+			if err != nil {
+				parseErr = fmt.Errorf("parse %q: %w", line, err)
+				break
+			}
+			digits = append(digits, num)
+		}
+
+		if parseErr == nil && len(digits) > 0 && len(digits) < indexSize {
+			parseErr = fmt.Errorf("parse %q: want at least %d batteries", line, indexSize)
+		}
+
+		if parseErr != nil {
+			parseErrs = append(parseErrs, parseErr)
+			continue
+		}
+
+		if len(digits) == 0 {
+			continue
+		}
+
+		startingIndex := 0
+
+		for i := range indexSize {
+			indexBoundary := startingIndex + ((len(digits) - startingIndex) - (indexSize - i)) + 1
+			index := startingIndex
+
+			for j := startingIndex; j < indexBoundary; j++ {
+				digit := digits[j]
+
+				if digit == 9 || digits[index] < digit {
+					index = j
+				}
+
+				if digits[j] == 9 {
+					break
+				}
+			}
+
+			startingIndex = index + 1
+			total += int64(digits[index]) * int64(math.Pow(10, float64(indexSize-i-1)))
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
@@ -0,0 +1,25 @@
+package day03
+
+import (
+	"testing"
+
+	"aoc/2025/aoctest"
+)
+
+var cases = []aoctest.Case{
+	{Part: 1, Want: 357},
+	{Part: 2, Want: 3121910778619},
+}
+
+var parts = aoctest.Parts{
+	One: aoctest.Plain(RunPartOne),
+	Two: aoctest.Plain(RunPartTwo),
+}
+
+func TestSolution(t *testing.T) {
+	aoctest.Run(t, parts, cases)
+}
+
+func FuzzPartOne(f *testing.F) { aoctest.Fuzz(f, parts.One, nil) }
+
+func FuzzPartTwo(f *testing.F) { aoctest.Fuzz(f, parts.Two, nil) }
@@ -0,0 +1,27 @@
+package day04
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// rollDensity is the share of cells holding a roll, about as in the real input.
+const rollDensity = 0.6
+
+// Generate returns a size by size grid of paper rolls.
+func Generate(rng *rand.Rand, size int) string {
+	var b strings.Builder
+
+	for range size {
+		for range size {
+			if rng.Float64() < rollDensity {
+				b.WriteString(ROLL)
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
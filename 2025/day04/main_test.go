@@ -1,21 +0,0 @@
-package main
-
-import "testing"
-
-func TestPartOne(t *testing.T) {
-	got := RunPartOne("test_input")
-	want := 13
-
-	if got != want {
-		t.Errorf("got %d want %d", got, want)
-	}
-}
-
-func TestPartTwo(t *testing.T) {
-	got := RunPartTwo("test_input")
-	want := 43
-
-	if got != want {
-		t.Errorf("got %d want %d", got, want)
-	}
-}
@@ -1,33 +1,47 @@
-package main
+package day04
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 
+	"aoc/2025/registry"
 	"aoc/2025/utils"
+	"aoc/2025/viz"
 )
 
 const EMPTY = -1
 const ROLL = "@"
 const MAX_MOVABLE_ROLLS = 4
 
-func main() {
-	submissionOneResult := RunPartOne("day04/input")
-	fmt.Println("Day 4 - First submission result:", submissionOneResult)
-
-	submissionTwoResult := RunPartTwo("day04/input")
-	fmt.Println("Day 4 - Second submission result:", submissionTwoResult)
+func init() {
+	registry.Register(registry.Day{
+		Day:      4,
+		PartOne:  RunPartOne,
+		PartTwo:  RunPartTwo,
+		Generate: Generate,
+	})
 }
 
-func RunPartOne(path string) int {
+func RunPartOne(ctx context.Context, input io.Reader) (int, error) {
 	var grid [][]int
 
-	lines, errs := utils.StreamFileLines(path)
+	lines, errs := utils.StreamLines(ctx, input)
 	rowIndex := 0
+	var parseErrs []error
 
 	// Parse lines
 	for line := range lines {
 		rowLength := len(line)
 
+		if len(grid) > 0 && rowLength+2 != len(grid[0]) {
+			parseErrs = append(parseErrs, fmt.Errorf("parse row %d: %d cells wide, want %d", rowIndex+1, rowLength, len(grid[0])-2))
+			rowIndex++
+			continue
+		}
+
 		if len(grid) == 0 {
 			grid = append(grid, createAndFill(rowLength+2, EMPTY))
 		}
@@ -45,27 +59,46 @@ func RunPartOne(path string) int {
 		rowIndex++
 	}
 
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	if len(grid) == 0 {
+		return 0, nil
+	}
+
 	grid = append(grid, createAndFill(len(grid[1]), EMPTY))
 
-	movableRolls, _ := removeRolls(grid)
+	movableRolls, nextGrid := removeRolls(grid)
 
-	if err := <-errs; err != nil {
-		fmt.Println("Error:", err)
+	if hook := viz.From(ctx); hook != nil {
+		hook(fmt.Sprintf("day 4: %d movable rolls", movableRolls), frame(grid, nextGrid))
 	}
 
-	return movableRolls
+	return movableRolls, nil
 }
 
-func RunPartTwo(path string) int {
+func RunPartTwo(ctx context.Context, input io.Reader) (int, error) {
 	var grid [][]int
 
-	lines, errs := utils.StreamFileLines(path)
+	lines, errs := utils.StreamLines(ctx, input)
 	rowIndex := 0
+	var parseErrs []error
 
 	// Parse lines
 	for line := range lines {
 		rowLength := len(line)
 
+		if len(grid) > 0 && rowLength+2 != len(grid[0]) {
+			parseErrs = append(parseErrs, fmt.Errorf("parse row %d: %d cells wide, want %d", rowIndex+1, rowLength, len(grid[0])-2))
+			rowIndex++
+			continue
+		}
+
 		if len(grid) == 0 {
 			grid = append(grid, createAndFill(rowLength+2, EMPTY))
 		}
@@ -83,12 +116,34 @@ func RunPartTwo(path string) int {
 		rowIndex++
 	}
 
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	if len(grid) == 0 {
+		return 0, nil
+	}
+
 	grid = append(grid, createAndFill(len(grid[1]), EMPTY))
 	rollsMoved := 0
+	hook := viz.From(ctx)
 
-	for true {
-		var rolls int
-		rolls, grid = removeRolls(grid)
+	for round := 1; true; round++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		rolls, nextGrid := removeRolls(grid)
+
+		if hook != nil {
+			hook(fmt.Sprintf("day 4 round %d: removed %d rolls, %d in total", round, rolls, rollsMoved+rolls), frame(grid, nextGrid))
+		}
+
+		grid = nextGrid
 
 		if rolls == 0 {
 			break
@@ -97,11 +152,7 @@ func RunPartTwo(path string) int {
 		rollsMoved += rolls
 	}
 
-	if err := <-errs; err != nil {
-		fmt.Println("Error:", err)
-	}
-
-	return rollsMoved
+	return rollsMoved, nil
 }
 
 func removeRolls(grid [][]int) (int, [][]int) {
@@ -125,6 +176,31 @@ func removeRolls(grid [][]int) (int, [][]int) {
 	return removedRolls, nextGrid
 }
 
+// frame draws a removal round: rolls that stay as @ and rolls removed from
+// grid in nextGrid as x, without the empty border.
+func frame(grid, nextGrid [][]int) viz.Grid {
+	g := viz.Grid{}
+
+	for row := 1; row < len(grid)-1; row++ {
+		var b strings.Builder
+
+		for cell := 1; cell < len(grid[row])-1; cell++ {
+			switch {
+			case grid[row][cell] == EMPTY:
+				b.WriteByte('.')
+			case nextGrid[row][cell] == EMPTY:
+				b.WriteByte('x')
+			default:
+				b.WriteString(ROLL)
+			}
+		}
+
+		g = append(g, b.String())
+	}
+
+	return g
+}
+
 func createAndFill[T any](length int, value T) []T {
 	s := make([]T, length)
 	for i := range s {
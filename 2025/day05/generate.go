@@ -0,0 +1,42 @@
+package day05
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+const (
+	// maxID bounds the generated IDs, which reach 15 digits in the real input.
+	maxID = 500_000_000_000_000
+	// idsPerRange is about the ratio of available IDs to fresh ranges in the
+	// real input.
+	idsPerRange = 5
+)
+
+// Generate returns size fresh ID ranges followed by five times as many
+// available IDs. A third of the ranges start inside or right after an earlier
+// one, so overlapping and touching ranges are common.
+func Generate(rng *rand.Rand, size int) string {
+	var b strings.Builder
+	ends := []int64{}
+
+	for range size {
+		start := 1 + rng.Int64N(maxID)
+		if len(ends) > 0 && rng.IntN(3) == 0 {
+			start = ends[rng.IntN(len(ends))] + 1 - rng.Int64N(2)
+		}
+
+		end := start + rng.Int64N(maxID/100)
+		ends = append(ends, end)
+		fmt.Fprintf(&b, "%d-%d\n", start, end)
+	}
+
+	b.WriteByte('\n')
+
+	for range size * idsPerRange {
+		fmt.Fprintf(&b, "%d\n", 1+rng.Int64N(maxID))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package day05
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"aoc/2025/difftest"
+	"aoc/2025/utils"
+)
+
+// parseReference reads the ranges and IDs without merging anything.
+func parseReference(ctx context.Context, input io.Reader) ([]RecipeIdRange, []int, error) {
+	ranges := []RecipeIdRange{}
+	ids := []int{}
+	readingIds := false
+
+	lines, errs := utils.StreamLines(ctx, input)
+	for line := range lines {
+		if line == "" {
+			readingIds = true
+			continue
+		}
+
+		if readingIds {
+			id, err := strconv.Atoi(line)
+			if err != nil {
+				return nil, nil, err
+			}
+			ids = append(ids, id)
+			continue
+		}
+
+		start, end, _ := strings.Cut(line, "-")
+		first, err := strconv.Atoi(start)
+		if err != nil {
+			return nil, nil, err
+		}
+		last, err := strconv.Atoi(end)
+		if err != nil {
+			return nil, nil, err
+		}
+		ranges = append(ranges, RecipeIdRange{Start: first, End: last})
+	}
+
+	return ranges, ids, <-errs
+}
+
+// referencePartOne checks every ID against every range.
+func referencePartOne(ctx context.Context, input io.Reader) (int, error) {
+	ranges, ids, err := parseReference(ctx, input)
+	fresh := 0
+
+	for _, id := range ids {
+		if slices.ContainsFunc(ranges, func(r RecipeIdRange) bool { return r.Start <= id && id <= r.End }) {
+			fresh++
+		}
+	}
+
+	return fresh, err
+}
+
+// referencePartTwo cuts the number line at every range boundary and counts
+// the pieces covered by any range.
+func referencePartTwo(ctx context.Context, input io.Reader) (int, error) {
+	ranges, _, err := parseReference(ctx, input)
+
+	bounds := []int{}
+	for _, r := range ranges {
+		bounds = append(bounds, r.Start, r.End+1)
+	}
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+
+	fresh := 0
+	for i := 0; i+1 < len(bounds); i++ {
+		low, high := bounds[i], bounds[i+1]
+		if slices.ContainsFunc(ranges, func(r RecipeIdRange) bool { return r.Start <= low && high-1 <= r.End }) {
+			fresh += high - low
+		}
+	}
+
+	return fresh, err
+}
+
+func TestPartOne_Reference(t *testing.T) {
+	err := difftest.Check(t.Context(), referencePartOne, RunPartOne, difftest.Config{Generate: Generate, Size: 30, Runs: 200})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPartTwo_Reference(t *testing.T) {
+	err := difftest.Check(t.Context(), referencePartTwo, RunPartTwo, difftest.Config{Generate: Generate, Size: 30, Runs: 200})
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,164 @@
+package day05
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"aoc/2025/registry"
+	"aoc/2025/utils"
+)
+
+func init() {
+	registry.Register(registry.Day{
+		Day:      5,
+		PartOne:  RunPartOne,
+		PartTwo:  RunPartTwo,
+		Generate: Generate,
+	})
+}
+
+type RecipeIdRange struct {
+	Start int
+	End   int
+}
+
+func RunPartOne(ctx context.Context, input io.Reader) (int, error) {
+	lines, errs := utils.StreamLines(ctx, input)
+	recipeIdRanges := make([]RecipeIdRange, 0)
+	checkRecipeIds := false
+	freshIngredients := 0
+	var parseErrs []error
+
+	// Parse lines
+	for line := range lines {
+		if len(line) == 0 && !checkRecipeIds {
+			checkRecipeIds = true
+		}
+
+		if !checkRecipeIds {
+			merged, err := merge(recipeIdRanges, line)
+			if err != nil {
+				parseErrs = append(parseErrs, err)
+				continue
+			}
+			recipeIdRanges = merged
+			continue
+		}
+
+		if len(line) == 0 {
+			continue
+		}
+
+		ingredient, err := strconv.Atoi(line)
+		if err != nil {
+			parseErrs = append(parseErrs, fmt.Errorf("parse %q: %w", line, err))
+			continue
+		}
+
+		for i := 0; i < len(recipeIdRanges); i++ {
+			recipeIdRange := recipeIdRanges[i]
+
+			if ingredient >= recipeIdRange.Start && ingredient <= recipeIdRange.End {
+				freshIngredients++
+				break
+			}
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	return freshIngredients, nil
+}
+
+func RunPartTwo(ctx context.Context, input io.Reader) (int, error) {
+	lines, errs := utils.StreamLines(ctx, input)
+	recipeIdRanges := make([]RecipeIdRange, 0)
+	freshIngredients := 0
+	breakLineRead := false
+	var parseErrs []error
+
+	// Parse lines
+	for line := range lines {
+		if breakLineRead {
+			continue
+		}
+
+		if len(line) == 0 {
+			breakLineRead = true
+			continue
+		}
+
+		merged, err := merge(recipeIdRanges, line)
+		if err != nil {
+			parseErrs = append(parseErrs, err)
+			continue
+		}
+		recipeIdRanges = merged
+	}
+
+	for i := 0; i < len(recipeIdRanges); i++ {
+		recipeIdRange := recipeIdRanges[i]
+		freshIngredients += recipeIdRange.End - recipeIdRange.Start + 1
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	return freshIngredients, nil
+}
+
+func merge(recipeIdRanges []RecipeIdRange, line string) ([]RecipeIdRange, error) {
+	first, last, found := strings.Cut(line, "-")
+	if !found {
+		return nil, fmt.Errorf("parse %q: want a range such as 3-5", line)
+	}
+
+	start, startErr := strconv.Atoi(first)
+	end, endErr := strconv.Atoi(last)
+	if err := errors.Join(startErr, endErr); err != nil {
+		return nil, fmt.Errorf("parse %q: %w", line, err)
+	}
+
+	if start > end {
+		return nil, fmt.Errorf("parse %q: range ends before it starts", line)
+	}
+
+	newRange := RecipeIdRange{Start: start, End: end}
+	merged := []RecipeIdRange{}
+
+	for i := range recipeIdRanges {
+		recipeIdRange := recipeIdRanges[i]
+
+		if start > recipeIdRange.End+1 || end < recipeIdRange.Start-1 {
+			merged = append(merged, recipeIdRange)
+			continue
+		}
+
+		if recipeIdRange.Start < start {
+			newRange.Start = recipeIdRange.Start
+		}
+
+		if recipeIdRange.End > end {
+			newRange.End = recipeIdRange.End
+		}
+	}
+
+	merged = append(merged, newRange)
+
+	return merged, nil
+}
@@ -0,0 +1,64 @@
+package day06
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// numberRows is the number of operand rows in the real input.
+const numberRows = 4
+
+// Generate returns size problems of four numbers of up to four digits each,
+// aligned left or right within their column as in the real input.
+func Generate(rng *rand.Rand, size int) string {
+	rows := make([]strings.Builder, numberRows+1)
+
+	for problem := range size {
+		numbers := make([]string, numberRows)
+		width := 0
+		for i := range numbers {
+			low := []int{1, 10, 100, 1000}[rng.IntN(4)]
+			numbers[i] = strconv.Itoa(low + rng.IntN(9*low))
+			width = max(width, len(numbers[i]))
+		}
+
+		// Lengths only grow or shrink down a problem, as in the real input,
+		// so the columns read by part two have no gaps between digits.
+		slices.SortFunc(numbers, func(a, b string) int { return cmp.Compare(len(a), len(b)) })
+		if rng.IntN(2) == 0 {
+			slices.Reverse(numbers)
+		}
+
+		left := rng.IntN(2) == 0
+		for i, number := range numbers {
+			padding := strings.Repeat(" ", width-len(number))
+			if left {
+				rows[i].WriteString(number + padding)
+			} else {
+				rows[i].WriteString(padding + number)
+			}
+		}
+
+		operation := "+"
+		if rng.IntN(2) == 0 {
+			operation = "*"
+		}
+		rows[numberRows].WriteString(operation + strings.Repeat(" ", width-1))
+
+		if problem < size-1 {
+			for i := range rows {
+				rows[i].WriteByte(' ')
+			}
+		}
+	}
+
+	var b strings.Builder
+	for i := range rows {
+		b.WriteString(rows[i].String() + "\n")
+	}
+
+	return b.String()
+}
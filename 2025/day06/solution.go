@@ -1,23 +1,28 @@
-package main
+package day06
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 
+	"aoc/2025/registry"
 	"aoc/2025/utils"
 )
 
-func main() {
-	submissionOneResult := RunPartOne("day06/input", 4)
-	fmt.Println("Day 6 - First submission result:", submissionOneResult)
-
-	submissionTwoResult := RunPartTwo("day06/input", 4)
-	fmt.Println("Day 6 - Second submission result:", submissionTwoResult)
+func init() {
+	registry.Register(registry.Day{
+		Day:      6,
+		PartOne:  func(ctx context.Context, input io.Reader) (int, error) { return RunPartOne(ctx, input, numberRows) },
+		PartTwo:  func(ctx context.Context, input io.Reader) (int, error) { return RunPartTwo(ctx, input, numberRows) },
+		Generate: Generate,
+	})
 }
 
-func RunPartOne(path string, operationLineIndex int) int {
-	fileLines, errs := utils.StreamFileLines(path)
+func RunPartOne(ctx context.Context, input io.Reader, operationLineIndex int) (int, error) {
+	fileLines, errs := utils.StreamLines(ctx, input)
 	lines := []string{}
 	lineLength := 0
 
@@ -30,10 +35,19 @@ func RunPartOne(path string, operationLineIndex int) int {
 		}
 	}
 
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := validate(lines, operationLineIndex); err != nil {
+		return 0, err
+	}
+
 	lines = ensureLineLength(lines, lineLength)
 
 	runningTotal := 0
 	columns := StreamOperations(lines[operationLineIndex])
+	var parseErrs []error
 
 	index := 0
 	for column := range columns {
@@ -48,8 +62,11 @@ func RunPartOne(path string, operationLineIndex int) int {
 				section = line[index : index+column.Width]
 			}
 
-			numberString := strings.TrimSpace(section)
-			number, _ := strconv.Atoi(numberString)
+			number, err := parseOperand(section)
+			if err != nil {
+				parseErrs = append(parseErrs, err)
+				continue
+			}
 
 			if total == 0 {
 				total = number
@@ -70,15 +87,15 @@ func RunPartOne(path string, operationLineIndex int) int {
 		index += column.Width
 	}
 
-	if err := <-errs; err != nil {
-		fmt.Println("Error:", err)
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
 	}
 
-	return runningTotal
+	return runningTotal, nil
 }
 
-func RunPartTwo(path string, operationLineIndex int) int {
-	fileLines, errs := utils.StreamFileLines(path)
+func RunPartTwo(ctx context.Context, input io.Reader, operationLineIndex int) (int, error) {
+	fileLines, errs := utils.StreamLines(ctx, input)
 	lines := []string{}
 	lineLength := 0
 
@@ -91,10 +108,19 @@ func RunPartTwo(path string, operationLineIndex int) int {
 		}
 	}
 
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := validate(lines, operationLineIndex); err != nil {
+		return 0, err
+	}
+
 	lines = ensureLineLength(lines, lineLength)
 
 	runningTotal := 0
 	columns := StreamOperations(lines[operationLineIndex])
+	var parseErrs []error
 
 	index := 0
 	for column := range columns {
@@ -111,7 +137,11 @@ func RunPartTwo(path string, operationLineIndex int) int {
 				}
 			}
 
-			number, _ := strconv.Atoi(strings.TrimSpace(numberString))
+			number, err := parseOperand(numberString)
+			if err != nil {
+				parseErrs = append(parseErrs, err)
+				continue
+			}
 
 			if total == 0 {
 				total = number
@@ -132,11 +162,41 @@ func RunPartTwo(path string, operationLineIndex int) int {
 		index += column.Width
 	}
 
-	if err := <-errs; err != nil {
-		fmt.Println("Error:", err)
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	return runningTotal, nil
+}
+
+// validate checks that there are operationLineIndex rows of numbers followed
+// by a row of operations, which StreamOperations can split into columns.
+func validate(lines []string, operationLineIndex int) error {
+	if operationLineIndex < 0 || len(lines) <= operationLineIndex {
+		return fmt.Errorf("want %d rows of numbers and a row of operations, got %d lines", operationLineIndex, len(lines))
+	}
+
+	operations := lines[operationLineIndex]
+	if strings.Trim(operations, "*+ ") != "" || (operations != "" && operations[0] == ' ') {
+		return fmt.Errorf("parse %q: want operations * or + at the start of each column", operations)
+	}
+
+	return nil
+}
+
+// parseOperand parses a number padded with spaces. A blank operand is zero.
+func parseOperand(section string) (int, error) {
+	numberString := strings.TrimSpace(section)
+	if numberString == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.Atoi(numberString)
+	if err != nil {
+		return 0, fmt.Errorf("parse %q: %w", section, err)
 	}
 
-	return runningTotal
+	return number, nil
 }
 
 type Column struct {
@@ -0,0 +1,31 @@
+package day06
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"aoc/2025/aoctest"
+)
+
+var cases = []aoctest.Case{
+	{Part: 1, Want: 4277556, Options: aoctest.Options{"rows": 3}},
+	{Part: 2, Want: 3263827, Options: aoctest.Options{"rows": 3}},
+}
+
+var parts = aoctest.Parts{
+	One: func(ctx context.Context, input io.Reader, opts aoctest.Options) (int, error) {
+		return RunPartOne(ctx, input, opts.Int("rows", numberRows))
+	},
+	Two: func(ctx context.Context, input io.Reader, opts aoctest.Options) (int, error) {
+		return RunPartTwo(ctx, input, opts.Int("rows", numberRows))
+	},
+}
+
+func TestSolution(t *testing.T) {
+	aoctest.Run(t, parts, cases)
+}
+
+func FuzzPartOne(f *testing.F) { aoctest.Fuzz(f, parts.One, aoctest.Options{"rows": 3}) }
+
+func FuzzPartTwo(f *testing.F) { aoctest.Fuzz(f, parts.Two, aoctest.Options{"rows": 3}) }
@@ -0,0 +1,37 @@
+package day07
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// splitterDensity is the chance that a cell of a splitter row holds one.
+const splitterDensity = 0.3
+
+// Generate returns a manifold of size rows, twice as wide, with the start at
+// the top centre. Splitters sit on every other row, never next to each other
+// or on the edges, so beams always stay inside the grid.
+func Generate(rng *rand.Rand, size int) string {
+	width := 2*size + 1
+	var b strings.Builder
+
+	for row := range size {
+		line := []byte(strings.Repeat(".", width))
+
+		switch {
+		case row == 0:
+			line[width/2] = 'S'
+		case row%2 == 0:
+			for column := 1; column < width-1; column++ {
+				if line[column-1] != '^' && rng.Float64() < splitterDensity {
+					line[column] = '^'
+				}
+			}
+		}
+
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
@@ -1,21 +1,29 @@
-package main
+package day07
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"slices"
+	"unicode/utf8"
 
+	"aoc/2025/registry"
 	"aoc/2025/utils"
+	"aoc/2025/viz"
 )
 
-func main() {
-	submissionOneResult := RunPartOne("day07/input")
-	fmt.Println("Day 7 - First submission result:", submissionOneResult)
-
-	submissionTwoResult := RunPartTwo("day07/input")
-	fmt.Println("Day 7 - Second submission result:", submissionTwoResult)
+func init() {
+	registry.Register(registry.Day{
+		Day:      7,
+		PartOne:  RunPartOne,
+		PartTwo:  RunPartTwo,
+		Generate: Generate,
+	})
 }
 
-func RunPartOne(path string) int {
-	lines, errs := utils.StreamFileLines(path)
+func RunPartOne(ctx context.Context, input io.Reader) (int, error) {
+	lines, errs := utils.StreamLines(ctx, input)
 	grid := []string{}
 
 	// Parse lines
@@ -23,8 +31,17 @@ func RunPartOne(path string) int {
 		grid = append(grid, line)
 	}
 
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := validateWidths(grid); err != nil {
+		return 0, err
+	}
+
 	processedGrid := []string{}
 	tachyonManifoldCount := 0
+	hook := viz.From(ctx)
 
 	for lineNum := 0; lineNum < len(grid); lineNum++ {
 		row := grid[lineNum]
@@ -33,7 +50,7 @@ func RunPartOne(path string) int {
 			prevLine := []rune(processedGrid[lineNum-1])
 			currentLine := []rune(grid[lineNum])
 
-			for cellNum := 0; cellNum < len(row); cellNum++ {
+			for cellNum := 0; cellNum < len(currentLine); cellNum++ {
 				cellAbove := prevLine[cellNum]
 				currentCell := currentLine[cellNum]
 
@@ -44,11 +61,11 @@ func RunPartOne(path string) int {
 
 					tachyonManifoldCount++
 
-					if currentLine[cellNum-1] == '.' {
+					if cellNum > 0 && currentLine[cellNum-1] == '.' {
 						currentLine[cellNum-1] = '|'
 					}
 
-					if currentLine[cellNum+1] == '.' {
+					if cellNum+1 < len(currentLine) && currentLine[cellNum+1] == '.' {
 						currentLine[cellNum+1] = '|'
 					}
 
@@ -64,13 +81,27 @@ func RunPartOne(path string) int {
 		}
 
 		processedGrid = append(processedGrid, row)
+
+		if hook != nil {
+			frame := append(viz.Grid(slices.Clone(processedGrid)), grid[lineNum+1:]...)
+			hook(fmt.Sprintf("day 7 row %d: %d splits", lineNum+1, tachyonManifoldCount), frame)
+		}
 	}
 
-	if err := <-errs; err != nil {
-		fmt.Println("Error:", err)
+	return tachyonManifoldCount, nil
+}
+
+// validateWidths checks that every row of the grid is as wide as the first.
+func validateWidths(grid []string) error {
+	var parseErrs []error
+
+	for i, row := range grid {
+		if width, want := utf8.RuneCountInString(row), utf8.RuneCountInString(grid[0]); width != want {
+			parseErrs = append(parseErrs, fmt.Errorf("parse row %d: %d cells wide, want %d", i+1, width, want))
+		}
 	}
 
-	return tachyonManifoldCount
+	return errors.Join(parseErrs...)
 }
 
 type Cell struct {
@@ -79,13 +110,19 @@ type Cell struct {
 	PathCount         int
 }
 
-func RunPartTwo(path string) int {
-	lines, errs := utils.StreamFileLines(path)
+func RunPartTwo(ctx context.Context, input io.Reader) (int, error) {
+	lines, errs := utils.StreamLines(ctx, input)
 	grid := [][]Cell{}
 
 	// Parse lines
 	lineNum := 0
+	var parseErrs []error
 	for line := range lines {
+		if lineNum > 0 && utf8.RuneCountInString(line) != len(grid[lineNum-1]) {
+			parseErrs = append(parseErrs, fmt.Errorf("parse row %d: %d cells wide, want %d", lineNum+len(parseErrs)+1, utf8.RuneCountInString(line), len(grid[lineNum-1])))
+			continue
+		}
+
 		if lineNum == 0 {
 			grid = append(grid, toCellRow(line, []Cell{}))
 		} else {
@@ -96,7 +133,15 @@ func RunPartTwo(path string) int {
 	}
 
 	if err := <-errs; err != nil {
-		fmt.Println("Error:", err)
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	if len(grid) == 0 {
+		return 0, nil
 	}
 
 	timelineCount := 0
@@ -107,7 +152,7 @@ func RunPartTwo(path string) int {
 		timelineCount += cell.PathCount
 	}
 
-	return timelineCount
+	return timelineCount, nil
 }
 
 func toCellRow(line string, prevLine []Cell) []Cell {
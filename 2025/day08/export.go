@@ -0,0 +1,72 @@
+package day08
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"image/color"
+	"io"
+	"slices"
+
+	"aoc/2025/viz"
+)
+
+// unconnected is the colour of boxes that are in no circuit yet.
+var unconnected = color.RGBA{0x7f, 0x84, 0x9c, 0xff}
+
+// Export connects the top closest pairs as part one does and returns the
+// boxes as a point cloud, coloured by circuit from the largest down, with
+// the connections made as edges.
+func Export(ctx context.Context, input io.Reader, top int) (*viz.Cloud, error) {
+	points, err := parse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := closestPairs(ctx, points)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs = pairs[:minimum(top, len(pairs))]
+
+	circuits := []Circuit{}
+	for _, pair := range pairs {
+		circuits, _ = connect(circuits, pair)
+	}
+
+	slices.SortStableFunc(circuits, func(a, b Circuit) int {
+		return cmp.Compare(len(b.Boxes), len(a.Boxes))
+	})
+
+	circuitOf := map[JunctionBox]int{}
+	for i, circuit := range circuits {
+		for _, box := range circuit.Boxes {
+			circuitOf[box] = i
+		}
+	}
+
+	cloud := &viz.Cloud{
+		Title: fmt.Sprintf("day 8: %d boxes, %d closest pairs connected into %d circuits", len(points), len(pairs), len(circuits)),
+	}
+
+	index := map[JunctionBox]int{}
+	for _, box := range points {
+		if _, ok := index[box]; ok {
+			continue
+		}
+
+		col := unconnected
+		if i, ok := circuitOf[box]; ok {
+			col = viz.Hue(i)
+		}
+
+		index[box] = cloud.Add(viz.Point3{X: float64(box.X), Y: float64(box.Y), Z: float64(box.Z)}, col)
+	}
+
+	for _, pair := range pairs {
+		cloud.Edges = append(cloud.Edges, [2]int{index[pair.BoxA], index[pair.BoxB]})
+	}
+
+	return cloud, nil
+}
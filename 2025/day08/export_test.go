@@ -0,0 +1,48 @@
+package day08
+
+import (
+	"image/color"
+	"os"
+	"testing"
+
+	"aoc/2025/viz"
+)
+
+func TestExport(t *testing.T) {
+	input, err := os.Open("test_input")
+	if err != nil {
+		t.Fatalf("failed to open input: %v", err)
+	}
+	defer input.Close()
+
+	cloud, err := Export(t.Context(), input, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := len(cloud.Points), 20; got != want {
+		t.Errorf("got %d points want %d", got, want)
+	}
+
+	if got, want := len(cloud.Edges), 10; got != want {
+		t.Errorf("got %d edges want %d", got, want)
+	}
+
+	// The example's largest circuits have 5 and 4 boxes and are coloured first.
+	counts := map[color.RGBA]int{}
+	for _, col := range cloud.Colors {
+		counts[col]++
+	}
+
+	for i, want := range []int{5, 4} {
+		if got := counts[viz.Hue(i)]; got != want {
+			t.Errorf("circuit %d: got %d boxes want %d", i, got, want)
+		}
+	}
+
+	for _, e := range cloud.Edges {
+		if cloud.Colors[e[0]] != cloud.Colors[e[1]] {
+			t.Errorf("edge %v joins boxes of different circuits", e)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package day08
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// maxCoordinate bounds each axis, as in the real input.
+const maxCoordinate = 100_000
+
+// Generate returns size junction boxes. Part one connects the 1000 closest
+// pairs, so it needs at least 46 boxes.
+func Generate(rng *rand.Rand, size int) string {
+	var b strings.Builder
+
+	for range size {
+		fmt.Fprintf(&b, "%d,%d,%d\n", rng.IntN(maxCoordinate), rng.IntN(maxCoordinate), rng.IntN(maxCoordinate))
+	}
+
+	return b.String()
+}
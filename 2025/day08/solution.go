@@ -0,0 +1,292 @@
+package day08
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"aoc/2025/registry"
+	"aoc/2025/trace"
+	"aoc/2025/utils"
+)
+
+func init() {
+	registry.Register(registry.Day{
+		Day:      8,
+		PartOne:  func(ctx context.Context, input io.Reader) (int, error) { return RunPartOne(ctx, input, 1000) },
+		PartTwo:  RunPartTwo,
+		Generate: Generate,
+	})
+}
+
+type JunctionBox struct {
+	X int
+	Y int
+	Z int
+}
+
+type JunctionBoxPair struct {
+	BoxA     JunctionBox
+	BoxB     JunctionBox
+	Distance float64
+}
+
+type Circuit struct {
+	Boxes []JunctionBox
+}
+
+func RunPartOne(ctx context.Context, input io.Reader, top int) (int, error) {
+	points, err := parse(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+
+	pairs, err := closestPairs(ctx, points)
+	if err != nil {
+		return 0, err
+	}
+
+	circuits := []Circuit{}
+	for i := range minimum(top, len(pairs)) {
+		circuits, _ = connect(circuits, pairs[i])
+	}
+
+	// Final sort
+	slices.SortFunc(circuits, func(a, b Circuit) int {
+		return cmp.Compare(len(b.Boxes), len(a.Boxes))
+	})
+
+	return calculateTopCircuits(circuits, 3), nil
+}
+
+func RunPartTwo(ctx context.Context, input io.Reader) (int, error) {
+	points, err := parse(ctx, input)
+	if err != nil {
+		return 0, err
+	}
+
+	pairs, err := closestPairs(ctx, points)
+	if err != nil {
+		return 0, err
+	}
+
+	circuits := []Circuit{}
+	var lastPair JunctionBoxPair
+
+	for i := range len(pairs) {
+		if i%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+		}
+
+		var connected bool
+		circuits, connected = connect(circuits, pairs[i])
+
+		if connected {
+			lastPair = pairs[i]
+		}
+	}
+
+	return lastPair.BoxA.X * lastPair.BoxB.X, nil
+}
+
+// parse reads the junction boxes, one X,Y,Z line each.
+func parse(ctx context.Context, input io.Reader) ([]JunctionBox, error) {
+	lines, errs := utils.StreamLines(ctx, input)
+	points := []JunctionBox{}
+
+	// Parse lines
+	var parseErrs []error
+	for line := range lines {
+		if line == "" {
+			continue
+		}
+
+		box, err := parseJunctionBox(line)
+		if err != nil {
+			parseErrs = append(parseErrs, err)
+			continue
+		}
+
+		points = append(points, box)
+	}
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+// closestPairs returns every pair of points, closest first.
+func closestPairs(ctx context.Context, points []JunctionBox) ([]JunctionBoxPair, error) {
+	pairs := []JunctionBoxPair{}
+
+	// Compare
+	for a := 0; a < len(points); a++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for b := a + 1; b < len(points); b++ {
+			pairs = append(pairs, JunctionBoxPair{
+				BoxA:     points[a],
+				BoxB:     points[b],
+				Distance: calculateEuclideanDistance(points[a], points[b]),
+			})
+		}
+	}
+
+	// Sort
+	slices.SortFunc(pairs, func(a, b JunctionBoxPair) int {
+		return cmp.Compare(a.Distance, b.Distance)
+	})
+
+	return pairs, nil
+}
+
+// connect puts both boxes of pair in the same circuit. It reports false when
+// they already were.
+func connect(circuits []Circuit, pair JunctionBoxPair) ([]Circuit, bool) {
+	circuitIndexA, foundA := findCircuitIndex(circuits, pair.BoxA)
+	circuitIndexB, foundB := findCircuitIndex(circuits, pair.BoxB)
+
+	if foundA && foundB && circuitIndexA == circuitIndexB {
+		return circuits, false
+	}
+
+	if trace.Enabled() {
+		trace.Event("connect", pair.BoxA, pair.BoxB, pair.Distance)
+	}
+
+	if foundA && foundB {
+		if trace.Enabled() {
+			trace.Event("merge", len(circuits[circuitIndexA].Boxes), len(circuits[circuitIndexB].Boxes))
+		}
+
+		return mergeCircuits(circuits, circuitIndexA, circuitIndexB), true
+	}
+
+	if !foundA && !foundB {
+		return append(circuits, Circuit{
+			Boxes: []JunctionBox{pair.BoxA, pair.BoxB},
+		}), true
+	}
+
+	if foundA {
+		circuits[circuitIndexA].Boxes = append(circuits[circuitIndexA].Boxes, pair.BoxB)
+		return circuits, true
+	}
+
+	circuits[circuitIndexB].Boxes = append(circuits[circuitIndexB].Boxes, pair.BoxA)
+	return circuits, true
+}
+
+// parseJunctionBox reads an X,Y,Z line.
+func parseJunctionBox(line string) (JunctionBox, error) {
+	dimensions := strings.Split(line, ",")
+	if len(dimensions) != 3 {
+		return JunctionBox{}, fmt.Errorf("parse %q: want X,Y,Z", line)
+	}
+
+	var coords [3]int
+	for i, dimension := range dimensions {
+		n, err := strconv.Atoi(dimension)
+		if err != nil {
+			return JunctionBox{}, fmt.Errorf("parse %q: %w", line, err)
+		}
+		coords[i] = n
+	}
+
+	return JunctionBox{X: coords[0], Y: coords[1], Z: coords[2]}, nil
+}
+
+func mergeCircuits(circuits []Circuit, circuitIndexA, circuitIndexB int) []Circuit {
+	nextCircuits := []Circuit{}
+
+	for i := range circuits {
+		if i == circuitIndexA || i == circuitIndexB {
+			continue
+		}
+
+		nextCircuits = append(nextCircuits, circuits[i])
+	}
+
+	nextCircuits = append(nextCircuits, Circuit{
+		Boxes: mergeBoxes(circuits[circuitIndexA].Boxes, circuits[circuitIndexB].Boxes),
+	})
+
+	return nextCircuits
+}
+
+func mergeBoxes(circuit1Boxes, circuit2Boxes []JunctionBox) []JunctionBox {
+	mergedBoxes := circuit1Boxes
+
+	for _, box := range circuit2Boxes {
+		if slices.Contains(mergedBoxes, box) {
+			continue
+		}
+
+		mergedBoxes = append(mergedBoxes, box)
+	}
+
+	return mergedBoxes
+}
+
+func findCircuitIndex(circuits []Circuit, box JunctionBox) (int, bool) {
+	for index, circuit := range circuits {
+		if slices.Contains(circuit.Boxes, box) {
+			return index, true
+		}
+	}
+	return -1, false
+}
+
+func calculateTopCircuits(circuits []Circuit, top int) int {
+	total := 1
+
+	for i := range minimum(top, len(circuits)) {
+		total *= len(circuits[i].Boxes)
+	}
+
+	return total
+}
+
+func calculateEuclideanDistance(pointOne JunctionBox, pointTwo JunctionBox) float64 {
+	xDistance := float64(pointOne.X - pointTwo.X)
+	yDistance := float64(pointOne.Y - pointTwo.Y)
+	zDistance := float64(pointOne.Z - pointTwo.Z)
+
+	xDistanceSquared := xDistance * xDistance
+	yDistanceSquared := yDistance * yDistance
+	zDistanceSquared := zDistance * zDistance
+
+	distance := math.Sqrt(xDistanceSquared + yDistanceSquared + zDistanceSquared)
+
+	return math.Abs(distance)
+}
+
+func minimum(numbers ...int) int {
+	if len(numbers) == 0 {
+		return 0 // or panic, depending on your needs
+	}
+
+	minVal := numbers[0]
+	for _, num := range numbers[1:] {
+		if num < minVal {
+			minVal = num
+		}
+	}
+	return minVal
+}
@@ -0,0 +1,29 @@
+package day08
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"aoc/2025/aoctest"
+)
+
+var cases = []aoctest.Case{
+	{Part: 1, Want: 40, Options: aoctest.Options{"top": 10}},
+	{Part: 2, Want: 25272},
+}
+
+var parts = aoctest.Parts{
+	One: func(ctx context.Context, input io.Reader, opts aoctest.Options) (int, error) {
+		return RunPartOne(ctx, input, opts.Int("top", 1000))
+	},
+	Two: aoctest.Plain(RunPartTwo),
+}
+
+func TestSolution(t *testing.T) {
+	aoctest.Run(t, parts, cases)
+}
+
+func FuzzPartOne(f *testing.F) { aoctest.Fuzz(f, parts.One, aoctest.Options{"top": 10}) }
+
+func FuzzPartTwo(f *testing.F) { aoctest.Fuzz(f, parts.Two, nil) }
@@ -0,0 +1,39 @@
+package day09
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// maxCoordinate bounds each axis, as in the real input.
+const maxCoordinate = 100_000
+
+// Generate returns about size red tiles forming a closed loop in which each
+// tile shares a row or column with the next, like the real input. The loop
+// outlines a histogram: steps of random height above a common floor.
+func Generate(rng *rand.Rand, size int) string {
+	steps := max(size/2-1, 1)
+	step := max(maxCoordinate/(steps+1), 1)
+	floor := rng.IntN(maxCoordinate / 10)
+
+	var b strings.Builder
+	x := rng.IntN(step) + 1
+	height := 0
+
+	fmt.Fprintf(&b, "%d,%d\n", x, floor)
+	for range steps {
+		next := height
+		for next == height {
+			next = floor + 1 + rng.IntN(maxCoordinate-floor)
+		}
+		height = next
+
+		fmt.Fprintf(&b, "%d,%d\n", x, height)
+		x += 1 + rng.IntN(step)
+		fmt.Fprintf(&b, "%d,%d\n", x, height)
+	}
+	fmt.Fprintf(&b, "%d,%d\n", x, floor)
+
+	return b.String()
+}
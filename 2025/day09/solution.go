@@ -0,0 +1,174 @@
+package day09
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"aoc/2025/registry"
+	"aoc/2025/trace"
+	"aoc/2025/utils"
+	"aoc/2025/viz"
+)
+
+func init() {
+	registry.Register(registry.Day{
+		Day:     9,
+		PartOne: RunPartOne,
+		// PartTwo: RunPartTwo,
+		Generate: Generate,
+	})
+}
+
+type Coordinate struct {
+	X int
+	Y int
+}
+
+type Box struct {
+	A    Coordinate
+	B    Coordinate
+	Area int
+}
+
+// Signed is a constraint for signed integer types
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+func Abs[T Signed](n T) T {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func RunPartOne(ctx context.Context, input io.Reader) (int, error) {
+	lines, errs := utils.StreamLines(ctx, input)
+	coordinates := []Coordinate{}
+	boxes := []Box{}
+
+	// Parse lines
+	var parseErrs []error
+	for line := range lines {
+		if line == "" {
+			continue
+		}
+
+		coordinate, err := parseCoordinate(line)
+		if err != nil {
+			parseErrs = append(parseErrs, err)
+			continue
+		}
+
+		coordinates = append(coordinates, coordinate)
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	if err := errors.Join(parseErrs...); err != nil {
+		return 0, err
+	}
+
+	for aIndex := 0; aIndex < len(coordinates); aIndex++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		for bIndex := aIndex + 1; bIndex < len(coordinates); bIndex++ {
+			a := coordinates[aIndex]
+			b := coordinates[bIndex]
+			area := calculateArea(a, b)
+
+			boxes = append(boxes, Box{
+				A:    a,
+				B:    b,
+				Area: area,
+			})
+		}
+	}
+
+	slices.SortFunc(boxes, func(a, b Box) int {
+		return cmp.Compare(b.Area, a.Area)
+	})
+
+	if len(boxes) == 0 {
+		return 0, nil
+	}
+
+	if hook := viz.SceneFrom(ctx); hook != nil {
+		hook(scene(coordinates, boxes[0]))
+	}
+
+	return boxes[0].Area, nil
+}
+
+// scene draws the loop of red tiles and the largest box between two of them.
+func scene(coordinates []Coordinate, best Box) *viz.Scene {
+	s := &viz.Scene{Title: fmt.Sprintf("day 9: largest rectangle %d", best.Area)}
+
+	loop := make([]viz.Point, len(coordinates))
+	for i, c := range coordinates {
+		loop[i] = viz.Point{X: float64(c.X), Y: float64(c.Y)}
+	}
+
+	s.Polygon(loop, viz.Style{Fill: "green", Stroke: "darkgreen", Opacity: 0.4})
+	s.Rect(viz.Rect{
+		Min: viz.Point{X: float64(best.A.X), Y: float64(best.A.Y)},
+		Max: viz.Point{X: float64(best.B.X), Y: float64(best.B.Y)},
+	}, viz.Style{Fill: "gold", Stroke: "orange", Opacity: 0.6})
+	for _, p := range loop {
+		s.Point(p, viz.Style{Fill: "red"})
+	}
+
+	return s
+}
+
+// parseCoordinate reads an X,Y line.
+func parseCoordinate(line string) (Coordinate, error) {
+	xs, ys, ok := strings.Cut(line, ",")
+	if !ok {
+		return Coordinate{}, fmt.Errorf("parse %q: want X,Y", line)
+	}
+
+	x, err := strconv.Atoi(xs)
+	if err != nil {
+		return Coordinate{}, fmt.Errorf("parse %q: %w", line, err)
+	}
+
+	y, err := strconv.Atoi(ys)
+	if err != nil {
+		return Coordinate{}, fmt.Errorf("parse %q: %w", line, err)
+	}
+
+	return Coordinate{X: x, Y: y}, nil
+}
+
+func calculateArea(a, b Coordinate) int {
+	x := 1 + Abs(a.X-b.X)
+	y := 1 + Abs(a.Y-b.Y)
+
+	return x * y
+}
+
+func RunPartTwo(ctx context.Context, input io.Reader) (int, error) {
+	lines, errs := utils.StreamLines(ctx, input)
+
+	// Parse lines
+	for line := range lines {
+		trace.Event("line", line)
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,17 @@
+package daytemplate
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// Generate returns size random lines in the day's input format.
+func Generate(rng *rand.Rand, size int) string {
+	var b strings.Builder
+
+	for range size {
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
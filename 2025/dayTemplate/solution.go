@@ -0,0 +1,50 @@
+package daytemplate
+
+import (
+	"context"
+	"io"
+
+	"aoc/2025/registry"
+	"aoc/2025/trace"
+	"aoc/2025/utils"
+)
+
+// Set Day and add the package to days/days.go when starting a new day.
+func init() {
+	registry.Register(registry.Day{
+		Day:      0,
+		PartOne:  RunPartOne,
+		PartTwo:  RunPartTwo,
+		Generate: Generate,
+	})
+}
+
+func RunPartOne(ctx context.Context, input io.Reader) (int, error) {
+	lines, errs := utils.StreamLines(ctx, input)
+
+	// Parse lines
+	for line := range lines {
+		trace.Event("line", line)
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	return 0, nil
+}
+
+func RunPartTwo(ctx context.Context, input io.Reader) (int, error) {
+	lines, errs := utils.StreamLines(ctx, input)
+
+	// Parse lines
+	for line := range lines {
+		trace.Event("line", line)
+	}
+
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,17 @@
+// Package days registers every day's solution with the registry. Import it
+// for its side effects:
+//
+//	import _ "aoc/2025/days"
+package days
+
+import (
+	_ "aoc/2025/day01"
+	_ "aoc/2025/day02"
+	_ "aoc/2025/day03"
+	_ "aoc/2025/day04"
+	_ "aoc/2025/day05"
+	_ "aoc/2025/day06"
+	_ "aoc/2025/day07"
+	_ "aoc/2025/day08"
+	_ "aoc/2025/day09"
+)
@@ -0,0 +1,103 @@
+package days
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"aoc/2025/answers"
+	"aoc/2025/bench"
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+// TestAnswers runs every solved part on its real input and compares the
+// result with the accepted answer in answers.json.
+func TestAnswers(t *testing.T) {
+	store, err := answers.Load("../" + answers.DefaultPath)
+	if err != nil {
+		t.Fatalf("failed to load answers: %v", err)
+	}
+
+	inputs := input.New("..")
+
+	for _, d := range registry.All() {
+		for part := 1; part <= 2; part++ {
+			if _, err := d.Part(part); err != nil {
+				continue
+			}
+
+			t.Run(fmt.Sprintf("day%02d/part%d", d.Day, part), func(t *testing.T) {
+				result, err := store.Check(t.Context(), inputs, d, part)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				switch result.Status {
+				case answers.Missing:
+					t.Skip("no accepted answer recorded")
+				case answers.NoInput:
+					t.Skip("no input")
+				case answers.Failed:
+					t.Errorf("unexpected error: %v", result.Err)
+				case answers.Mismatch:
+					t.Errorf("got %s want %s", result.Got, result.Want)
+				}
+			})
+		}
+	}
+}
+
+// TestGenerators runs every solved part on generated inputs, which must be
+// reproducible from their seed and solvable without errors.
+func TestGenerators(t *testing.T) {
+	const size = 50
+
+	for _, d := range registry.All() {
+		if d.Generate == nil {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("day%02d", d.Day), func(t *testing.T) {
+			for seed := range uint64(3) {
+				generated := d.Generate(rand.New(rand.NewPCG(seed, 0)), size)
+				if again := d.Generate(rand.New(rand.NewPCG(seed, 0)), size); again != generated {
+					t.Fatalf("seed %d: generated different inputs", seed)
+				}
+
+				for part := 1; part <= 2; part++ {
+					solver, err := d.Part(part)
+					if err != nil {
+						continue
+					}
+
+					if _, err := solver(t.Context(), strings.NewReader(generated)); err != nil {
+						t.Errorf("seed %d part %d: unexpected error: %v", seed, part, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSolvers measures every solved part on its real input.
+func BenchmarkSolvers(b *testing.B) {
+	inputs := input.New("..")
+
+	for _, d := range registry.All() {
+		data, err := inputs.ReadAll(d)
+		if err != nil {
+			continue
+		}
+
+		for part := 1; part <= 2; part++ {
+			solver, err := d.Part(part)
+			if err != nil {
+				continue
+			}
+
+			b.Run(fmt.Sprintf("day%02d/part%d", d.Day, part), bench.Func(solver, data))
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Package difftest compares an optimized solver with a reference one on many
+// generated inputs. When they disagree, the input is shrunk to a small one on
+// which they still do, so the mismatch is easy to read.
+package difftest
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"aoc/2025/registry"
+)
+
+// Config controls Check.
+type Config struct {
+	// Generate produces the inputs, usually a day's registered generator.
+	Generate registry.Generator
+	// Size is passed to Generate.
+	Size int
+	// Runs is the number of inputs tried.
+	Runs int
+	// Seed makes runs reproducible; run i uses Seed+i.
+	Seed uint64
+}
+
+// Mismatch is an input on which the candidate disagrees with the reference.
+type Mismatch struct {
+	Seed  uint64
+	Input string
+	Want  int
+	Got   int
+	Err   error
+}
+
+func (m *Mismatch) Error() string {
+	got := fmt.Sprint(m.Got)
+	if m.Err != nil {
+		got = "error " + m.Err.Error()
+	}
+
+	return fmt.Sprintf("seed %d: got %s want %d for shrunk input:\n%s", m.Seed, got, m.Want, m.Input)
+}
+
+// Check runs reference and candidate on cfg.Runs generated inputs. It returns
+// a *Mismatch for the first input they disagree on, or an error when the
+// reference itself fails, which points at the generator.
+func Check(ctx context.Context, reference, candidate registry.Part, cfg Config) error {
+	for i := range cfg.Runs {
+		seed := cfg.Seed + uint64(i)
+		input := cfg.Generate(rand.New(rand.NewPCG(seed, 0)), cfg.Size)
+
+		if _, err := reference(ctx, strings.NewReader(input)); err != nil {
+			return fmt.Errorf("seed %d: reference failed: %w", seed, err)
+		}
+
+		disagree := func(input string) bool {
+			return disagreement(ctx, reference, candidate, input)
+		}
+		if !disagree(input) {
+			continue
+		}
+
+		shrunk := Shrink(input, disagree)
+		mismatch := &Mismatch{Seed: seed, Input: shrunk}
+		mismatch.Want, _ = reference(ctx, strings.NewReader(shrunk))
+		mismatch.Got, mismatch.Err = candidate(ctx, strings.NewReader(shrunk))
+		return mismatch
+	}
+
+	return nil
+}
+
+// disagreement reports whether the candidate fails or answers differently on
+// an input the reference accepts.
+func disagreement(ctx context.Context, reference, candidate registry.Part, input string) bool {
+	want, err := reference(ctx, strings.NewReader(input))
+	if err != nil {
+		return false
+	}
+
+	got, err := candidate(ctx, strings.NewReader(input))
+	return err != nil || got != want
+}
+
+// Shrink returns a smaller input for which failing still holds. It removes
+// ever smaller chunks of lines, then comma and space separated tokens within
+// the remaining lines.
+func Shrink(input string, failing func(string) bool) string {
+	lines := strings.Split(strings.TrimSuffix(input, "\n"), "\n")
+	lines = shrinkSlice(lines, func(lines []string) bool {
+		return failing(joinLines(lines))
+	})
+
+	for i := range lines {
+		for _, separator := range []string{",", " "} {
+			tokens := strings.Split(lines[i], separator)
+			if len(tokens) < 2 {
+				continue
+			}
+
+			tokens = shrinkSlice(tokens, func(tokens []string) bool {
+				candidate := slices.Clone(lines)
+				candidate[i] = strings.Join(tokens, separator)
+				return failing(joinLines(candidate))
+			})
+			lines[i] = strings.Join(tokens, separator)
+		}
+	}
+
+	return joinLines(lines)
+}
+
+// shrinkSlice removes chunks of items, halving the chunk size down to single
+// items, as long as failing holds for what is left.
+func shrinkSlice(items []string, failing func([]string) bool) []string {
+	for chunk := max(len(items)/2, 1); chunk >= 1; chunk /= 2 {
+		for start := 0; start < len(items) && len(items) > 1; {
+			end := min(start+chunk, len(items))
+			candidate := slices.Concat(items[:start], items[end:])
+
+			if len(candidate) > 0 && failing(candidate) {
+				items = candidate
+				continue
+			}
+
+			start = end
+		}
+	}
+
+	return items
+}
+
+func joinLines(lines []string) string {
+	return strings.Join(lines, "\n") + "\n"
+}
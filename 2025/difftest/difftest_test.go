@@ -0,0 +1,97 @@
+package difftest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"aoc/2025/utils"
+)
+
+// numbers generates size lines of one number each.
+func numbers(rng *rand.Rand, size int) string {
+	var b strings.Builder
+	for range size {
+		fmt.Fprintf(&b, "%d\n", rng.IntN(100))
+	}
+	return b.String()
+}
+
+// sum adds the numbers of every line, skipping those skip reports.
+func sum(skip func(int) bool) func(context.Context, io.Reader) (int, error) {
+	return func(ctx context.Context, input io.Reader) (int, error) {
+		total := 0
+		lines, errs := utils.StreamLines(ctx, input)
+
+		for line := range lines {
+			for field := range strings.SplitSeq(line, ",") {
+				n, err := strconv.Atoi(field)
+				if err != nil {
+					return 0, err
+				}
+				if !skip(n) {
+					total += n
+				}
+			}
+		}
+
+		return total, <-errs
+	}
+}
+
+func TestCheck_Agree(t *testing.T) {
+	reference := sum(func(int) bool { return false })
+	candidate := sum(func(n int) bool { return n == 0 })
+
+	err := Check(t.Context(), reference, candidate, Config{Generate: numbers, Size: 20, Runs: 50})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCheck_ShrinksMismatch(t *testing.T) {
+	reference := sum(func(int) bool { return false })
+	candidate := sum(func(n int) bool { return n%10 == 7 })
+
+	err := Check(t.Context(), reference, candidate, Config{Generate: numbers, Size: 200, Runs: 10, Seed: 1})
+
+	var mismatch *Mismatch
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("got %v, want a mismatch", err)
+	}
+
+	n, convErr := strconv.Atoi(strings.TrimSpace(mismatch.Input))
+	if convErr != nil || n%10 != 7 {
+		t.Errorf("got shrunk input %q, want a single number ending in 7", mismatch.Input)
+	}
+
+	if mismatch.Want != n || mismatch.Got != 0 {
+		t.Errorf("got want %d and got %d for %q", mismatch.Want, mismatch.Got, mismatch.Input)
+	}
+}
+
+func TestCheck_ReferenceFails(t *testing.T) {
+	failing := func(context.Context, io.Reader) (int, error) { return 0, errors.New("bad input") }
+
+	err := Check(t.Context(), failing, failing, Config{Generate: numbers, Size: 1, Runs: 1})
+	if err == nil || !strings.Contains(err.Error(), "reference failed") {
+		t.Errorf("got %v, want reference failure", err)
+	}
+}
+
+func TestShrink_Tokens(t *testing.T) {
+	input := "1,2,3,4,5,6,7,8\n"
+
+	got := Shrink(input, func(s string) bool {
+		return strings.Contains(s, "3") && strings.Contains(s, "6")
+	})
+
+	if got != "3,6\n" {
+		t.Errorf("got %q want %q", got, "3,6\n")
+	}
+}
@@ -0,0 +1,153 @@
+package input
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// Magic starts every encrypted input.
+	Magic = "AOC-INPUT-AES-GCM-1\n"
+	// KeyEnv names the key file, replacing ~/.adventofcode.key.
+	KeyEnv = "AOC_INPUT_KEY"
+	// keySize is the length of an input key in bytes.
+	keySize = 32
+)
+
+var (
+	// ErrNoKey is returned when an encrypted input is met without a key.
+	ErrNoKey = errors.New("no input key")
+	// ErrDecrypt is returned for inputs that do not open with the key.
+	ErrDecrypt = errors.New("input does not decrypt with this key")
+)
+
+// DefaultKeyPath returns $AOC_INPUT_KEY, or ~/.adventofcode.key.
+func DefaultKeyPath() (string, error) {
+	if path := os.Getenv(KeyEnv); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".adventofcode.key"), nil
+}
+
+// GenerateKey returns a new random key, hex encoded as stored in key files.
+func GenerateKey() string {
+	key := make([]byte, keySize)
+	rand.Read(key)
+	return hex.EncodeToString(key)
+}
+
+// LoadKey reads a hex encoded key from path.
+func LoadKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s does not exist; copy the key there or set %s", ErrNoKey, path, KeyEnv)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != keySize {
+		return nil, fmt.Errorf("%s does not hold a %d byte hex key", path, keySize)
+	}
+
+	return key, nil
+}
+
+// Encrypted reports whether data is an encrypted input.
+func Encrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(Magic))
+}
+
+// Encrypt seals data with AES-GCM. The nonce is an HMAC of the plaintext, so
+// an unchanged input always encrypts to the same bytes, as a git clean filter
+// needs. Data that is already encrypted is returned unchanged.
+func Encrypt(key, data []byte) ([]byte, error) {
+	if Encrypted(data) {
+		return data, nil
+	}
+
+	aead, nonceKey, err := ciphers(key)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, nonceKey)
+	mac.Write(data)
+	nonce := mac.Sum(nil)[:aead.NonceSize()]
+
+	sealed := append([]byte(Magic), nonce...)
+	return aead.Seal(sealed, nonce, data, []byte(Magic)), nil
+}
+
+// Decrypt opens data sealed by Encrypt. Plain data is returned unchanged.
+func Decrypt(key, data []byte) ([]byte, error) {
+	if !Encrypted(data) {
+		return data, nil
+	}
+
+	aead, _, err := ciphers(key)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := data[len(Magic):]
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrDecrypt
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, ciphertext, []byte(Magic))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plain, nil
+}
+
+// ciphers derives separate keys for AES-GCM and for the nonce HMAC.
+func ciphers(key []byte) (cipher.AEAD, []byte, error) {
+	if len(key) != keySize {
+		return nil, nil, fmt.Errorf("input key must be %d bytes, got %d", keySize, len(key))
+	}
+
+	aesKey, err := hkdf.Key(sha256.New, key, nil, "aoc input encryption", keySize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonceKey, err := hkdf.Key(sha256.New, key, nil, "aoc input nonce", keySize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	block, err := aes.NewCipher(aesKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return aead, nonceKey, nil
+}
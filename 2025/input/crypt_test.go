@@ -0,0 +1,130 @@
+package input
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"aoc/2025/registry"
+)
+
+func testKey(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := hex.DecodeString(GenerateKey())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return key
+}
+
+func TestEncrypt_RoundTrip(t *testing.T) {
+	key := testKey(t)
+	plain := []byte("L68\nL30\nR48\n")
+
+	sealed, err := Encrypt(key, plain)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !Encrypted(sealed) || bytes.Contains(sealed, plain) {
+		t.Fatalf("input was not encrypted: %q", sealed)
+	}
+
+	got, err := Decrypt(key, sealed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(got, plain) {
+		t.Errorf("got %q want %q", got, plain)
+	}
+}
+
+func TestEncrypt_Deterministic(t *testing.T) {
+	key := testKey(t)
+
+	first, _ := Encrypt(key, []byte("1\n2\n"))
+	second, _ := Encrypt(key, []byte("1\n2\n"))
+	if !bytes.Equal(first, second) {
+		t.Error("same input encrypted to different bytes")
+	}
+
+	other, _ := Encrypt(key, []byte("1\n3\n"))
+	if bytes.Equal(first[:len(Magic)+12], other[:len(Magic)+12]) {
+		t.Error("different inputs share a nonce")
+	}
+
+	again, err := Encrypt(key, first)
+	if err != nil || !bytes.Equal(again, first) {
+		t.Errorf("encrypting twice changed the input: %v", err)
+	}
+}
+
+func TestDecrypt_PlainAndWrongKey(t *testing.T) {
+	key := testKey(t)
+
+	got, err := Decrypt(key, []byte("plain"))
+	if err != nil || string(got) != "plain" {
+		t.Errorf("got %q, %v want plain input unchanged", got, err)
+	}
+
+	sealed, _ := Encrypt(key, []byte("secret"))
+	if _, err := Decrypt(testKey(t), sealed); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("got %v, want ErrDecrypt", err)
+	}
+}
+
+func TestLoadKey(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := LoadKey(filepath.Join(dir, "missing")); !errors.Is(err, ErrNoKey) {
+		t.Errorf("got %v, want ErrNoKey", err)
+	}
+
+	bad := filepath.Join(dir, "bad")
+	if err := os.WriteFile(bad, []byte("not hex"), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	if _, err := LoadKey(bad); err == nil {
+		t.Error("expected an error for a malformed key")
+	}
+
+	good := filepath.Join(dir, "good")
+	if err := os.WriteFile(good, []byte(GenerateKey()+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	if key, err := LoadKey(good); err != nil || len(key) != keySize {
+		t.Errorf("got %d byte key, %v", len(key), err)
+	}
+}
+
+func TestResolver_DecryptsInputs(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "key")
+	hexKey := GenerateKey()
+	if err := os.WriteFile(keyPath, []byte(hexKey), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	key, _ := hex.DecodeString(hexKey)
+	sealed, err := Encrypt(key, []byte("987\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fsys := fstest.MapFS{"day03/input": {Data: sealed}}
+
+	r := &Resolver{FS: fsys, KeyPath: keyPath}
+	if got := read(t, r, 3); got != "987\n" {
+		t.Errorf("got %q want %q", got, "987\n")
+	}
+
+	missing := &Resolver{FS: fsys, KeyPath: filepath.Join(t.TempDir(), "key")}
+	if _, err := missing.Open(registry.Day{Day: 3}); !errors.Is(err, ErrNoKey) {
+		t.Errorf("got %v, want ErrNoKey", err)
+	}
+}
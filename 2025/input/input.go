@@ -0,0 +1,248 @@
+// Package input locates the puzzle input of each day independently of the
+// working directory. Inputs are resolved against the module root, unless an
+// override names a single file or standard input.
+package input
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	aoc "aoc/2025"
+	"aoc/2025/registry"
+)
+
+const (
+	// RootEnv names the module root, skipping the search from the working
+	// directory.
+	RootEnv = "AOC_ROOT"
+	// OverrideEnv names a file used as the input of a day, or Stdin, when no
+	// -input flag is given.
+	OverrideEnv = "AOC_INPUT"
+	// Stdin is the override that reads the input from standard input.
+	Stdin = "-"
+)
+
+// modulePath identifies the go.mod of the module holding the days.
+const modulePath = "aoc/2025"
+
+// ErrNoRoot is returned when no module root could be found.
+var ErrNoRoot = errors.New("module root not found")
+
+// FindRoot walks up from dir to the directory holding the module's go.mod.
+func FindRoot(dir string) (string, error) {
+	start, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for dir = start; ; dir = filepath.Dir(dir) {
+		if isModuleRoot(dir) {
+			return dir, nil
+		}
+
+		if filepath.Dir(dir) == dir {
+			return "", fmt.Errorf("%w from %s; run inside the 2025 directory or set %s", ErrNoRoot, start, RootEnv)
+		}
+	}
+}
+
+func isModuleRoot(dir string) bool {
+	file, err := os.Open(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.TrimSpace(module) == modulePath
+		}
+	}
+
+	return false
+}
+
+// Resolver opens the input of a day.
+type Resolver struct {
+	// FS holds the dayNN/input files.
+	FS fs.FS
+	// Root is where FS was loaded from, used in error messages.
+	Root string
+	// Override is a file read instead of every day's input, or Stdin.
+	Override string
+	// Stdin is read, once, when Override is Stdin.
+	Stdin io.Reader
+	// KeyPath is the key file used to decrypt encrypted inputs.
+	KeyPath string
+
+	stdinOnce sync.Once
+	stdin     []byte
+	stdinErr  error
+
+	keyOnce sync.Once
+	key     []byte
+	keyErr  error
+}
+
+// New returns a resolver reading inputs below the module root.
+func New(root string) *Resolver {
+	return NewFS(os.DirFS(root), root)
+}
+
+// NewFS returns a resolver reading inputs from fsys, which is named root in
+// error messages.
+func NewFS(fsys fs.FS, root string) *Resolver {
+	keyPath, _ := DefaultKeyPath()
+	return &Resolver{FS: fsys, Root: root, Stdin: os.Stdin, KeyPath: keyPath}
+}
+
+// Root returns the module root named by $AOC_ROOT or found from the working
+// directory.
+func Root() (string, error) {
+	if root := os.Getenv(RootEnv); root != "" {
+		return root, nil
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	return FindRoot(wd)
+}
+
+// Default returns a resolver for the module root named by $AOC_ROOT or found
+// from the working directory. Outside a checkout it falls back to the inputs
+// embedded in the binary, if any. A non-empty override replaces every day's
+// input and needs neither.
+func Default(override string) (*Resolver, error) {
+	r, err := defaultResolver(override)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Override = override
+	return r, nil
+}
+
+func defaultResolver(override string) (*Resolver, error) {
+	root, err := Root()
+	switch {
+	case err == nil:
+		return New(root), nil
+	case aoc.Inputs != nil:
+		return NewFS(aoc.Inputs, "(embedded)"), nil
+	case override != "":
+		return NewFS(nil, ""), nil
+	default:
+		return nil, err
+	}
+}
+
+// Open returns the input of d, decrypted when it was encrypted with Encrypt;
+// the caller closes it. A missing input wraps fs.ErrNotExist and says where
+// the input was expected.
+func (r *Resolver) Open(d registry.Day) (io.ReadCloser, error) {
+	in, err := r.open(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.decrypt(in)
+}
+
+func (r *Resolver) open(d registry.Day) (io.ReadCloser, error) {
+	switch r.Override {
+	case "":
+	case Stdin:
+		r.stdinOnce.Do(func() {
+			r.stdin, r.stdinErr = io.ReadAll(r.Stdin)
+		})
+		if r.stdinErr != nil {
+			return nil, fmt.Errorf("read input from stdin: %w", r.stdinErr)
+		}
+		return io.NopCloser(bytes.NewReader(r.stdin)), nil
+	default:
+		return os.Open(r.Override)
+	}
+
+	file, err := r.FS.Open(d.Input())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no input at %s; download it from %s or pass -input: %w",
+			filepath.Join(r.Root, d.Input()), URL(d.Day), fs.ErrNotExist)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// decrypt passes plain inputs through and decrypts encrypted ones, which are
+// recognised by their Magic header.
+func (r *Resolver) decrypt(in io.ReadCloser) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(in)
+	if head, _ := buffered.Peek(len(Magic)); !Encrypted(head) {
+		return readCloser{buffered, in}, nil
+	}
+	defer in.Close()
+
+	data, err := io.ReadAll(buffered)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := r.loadKey()
+	if err != nil {
+		return nil, fmt.Errorf("input is encrypted: %w", err)
+	}
+
+	plain, err := Decrypt(key, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(plain)), nil
+}
+
+func (r *Resolver) loadKey() ([]byte, error) {
+	r.keyOnce.Do(func() {
+		if r.KeyPath == "" {
+			r.keyErr = fmt.Errorf("%w: set %s", ErrNoKey, KeyEnv)
+			return
+		}
+		r.key, r.keyErr = LoadKey(r.KeyPath)
+	})
+
+	return r.key, r.keyErr
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// ReadAll returns the whole input of d.
+func (r *Resolver) ReadAll(d registry.Day) ([]byte, error) {
+	file, err := r.Open(d)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+// URL returns the page a day's input is downloaded from.
+func URL(day int) string {
+	return fmt.Sprintf("https://adventofcode.com/%d/day/%d/input", registry.Year, day)
+}
@@ -0,0 +1,105 @@
+package input
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"aoc/2025/registry"
+)
+
+func read(t *testing.T, r *Resolver, day int) string {
+	t.Helper()
+
+	file, err := r.Open(registry.Day{Day: day})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(data)
+}
+
+func TestFindRoot(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module aoc/2025\n\ngo 1.25\n"), 0o644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	nested := filepath.Join(root, "day03", "notes")
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+
+	got, err := FindRoot(nested)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != root {
+		t.Errorf("got %s want %s", got, root)
+	}
+}
+
+func TestFindRoot_OtherModule(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/other\n"), 0o644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	if _, err := FindRoot(dir); !errors.Is(err, ErrNoRoot) {
+		t.Errorf("got %v, want ErrNoRoot", err)
+	}
+}
+
+func TestResolver_Open(t *testing.T) {
+	r := &Resolver{FS: fstest.MapFS{"day03/input": {Data: []byte("987")}}, Root: "/aoc"}
+
+	if got := read(t, r, 3); got != "987" {
+		t.Errorf("got %q want %q", got, "987")
+	}
+
+	_, err := r.Open(registry.Day{Day: 4})
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("got %v, want fs.ErrNotExist", err)
+	}
+
+	for _, want := range []string{filepath.Join("/aoc", "day04", "input"), URL(4), "-input"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestResolver_OverrideFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "other")
+	if err := os.WriteFile(path, []byte("123"), 0o644); err != nil {
+		t.Fatalf("failed to write input: %v", err)
+	}
+
+	r := &Resolver{FS: fstest.MapFS{}, Override: path}
+
+	if got := read(t, r, 1); got != "123" {
+		t.Errorf("got %q want %q", got, "123")
+	}
+}
+
+func TestResolver_OverrideStdin(t *testing.T) {
+	r := &Resolver{FS: fstest.MapFS{}, Override: Stdin, Stdin: strings.NewReader("L68\nR48")}
+
+	// Both parts read the same input, so stdin is buffered on first use.
+	for range 2 {
+		if got := read(t, r, 1); got != "L68\nR48" {
+			t.Errorf("got %q want %q", got, "L68\nR48")
+		}
+	}
+}
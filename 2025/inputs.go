@@ -0,0 +1,13 @@
+// Package aoc holds the puzzle inputs compiled into the binary when it is
+// built with the embedinputs tag:
+//
+//	go build -tags embedinputs ./cmd/aoc
+//
+// Inputs are private, so public builds leave the tag off.
+package aoc
+
+import "io/fs"
+
+// Inputs holds every dayNN/input and dayNN/test_input file, or is nil when
+// the inputs are not embedded.
+var Inputs fs.FS
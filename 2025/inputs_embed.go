@@ -0,0 +1,12 @@
+//go:build embedinputs
+
+package aoc
+
+import "embed"
+
+//go:embed day*/input day*/test_input
+var embedded embed.FS
+
+func init() {
+	Inputs = embedded
+}
@@ -0,0 +1,20 @@
+//go:build embedinputs
+
+package aoc
+
+import (
+	"io/fs"
+	"testing"
+)
+
+func TestInputs(t *testing.T) {
+	if Inputs == nil {
+		t.Fatal("expected inputs to be embedded")
+	}
+
+	for _, name := range []string{"day01/input", "day01/test_input"} {
+		if _, err := fs.Stat(Inputs, name); err != nil {
+			t.Errorf("%s is not embedded: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Package leaderboard reads the JSON of an Advent of Code private leaderboard
+// and works out each member's stars, local score and how long they took
+// between the two parts of a day.
+package leaderboard
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Leaderboard is a private leaderboard for one event.
+type Leaderboard struct {
+	Event   int
+	OwnerID int
+	// Members are ordered by id.
+	Members []Member
+}
+
+// Member is one participant of a leaderboard.
+type Member struct {
+	ID   int
+	Name string
+	// Stars and LocalScore are as reported by the site.
+	Stars      int
+	LocalScore int
+	// Completed holds the two stars of each day the member has started.
+	Completed map[int][2]Star
+}
+
+// Star is when a member solved one part. The zero Star has not been earned.
+type Star struct {
+	Time time.Time
+	// Index orders stars earned within the same second.
+	Index int
+}
+
+// Earned reports whether the star has been earned.
+func (s Star) Earned() bool {
+	return !s.Time.IsZero()
+}
+
+// before reports whether s was earned ahead of other.
+func (s Star) before(other Star) bool {
+	if !s.Time.Equal(other.Time) {
+		return s.Time.Before(other.Time)
+	}
+	return s.Index < other.Index
+}
+
+// Delta returns how long the member took from part one to part two of day,
+// and false until both stars are earned.
+func (m Member) Delta(day int) (time.Duration, bool) {
+	stars := m.Completed[day]
+	if !stars[0].Earned() || !stars[1].Earned() {
+		return 0, false
+	}
+	return stars[1].Time.Sub(stars[0].Time), true
+}
+
+type rawStar struct {
+	Timestamp int64 `json:"get_star_ts"`
+	Index     int   `json:"star_index"`
+}
+
+type rawMember struct {
+	ID         int                           `json:"id"`
+	Name       *string                       `json:"name"`
+	Stars      int                           `json:"stars"`
+	LocalScore int                           `json:"local_score"`
+	Completed  map[string]map[string]rawStar `json:"completion_day_level"`
+}
+
+type rawLeaderboard struct {
+	Event   string               `json:"event"`
+	OwnerID int                  `json:"owner_id"`
+	Members map[string]rawMember `json:"members"`
+}
+
+// Parse reads a leaderboard in the format served at
+// /<year>/leaderboard/private/view/<id>.json.
+func Parse(r io.Reader) (*Leaderboard, error) {
+	var raw rawLeaderboard
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	event, err := strconv.Atoi(raw.Event)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event %q", raw.Event)
+	}
+
+	lb := &Leaderboard{Event: event, OwnerID: raw.OwnerID}
+	for _, key := range slices.Sorted(maps.Keys(raw.Members)) {
+		rm := raw.Members[key]
+
+		// The site shows members who hide their name like this.
+		name := fmt.Sprintf("(anonymous user #%d)", rm.ID)
+		if rm.Name != nil && *rm.Name != "" {
+			name = *rm.Name
+		}
+
+		m := Member{ID: rm.ID, Name: name, Stars: rm.Stars, LocalScore: rm.LocalScore, Completed: map[int][2]Star{}}
+		for dayKey, parts := range rm.Completed {
+			day, err := strconv.Atoi(dayKey)
+			if err != nil || day < 1 {
+				return nil, fmt.Errorf("member %d: invalid day %q", rm.ID, dayKey)
+			}
+
+			var stars [2]Star
+			for partKey, star := range parts {
+				part, err := strconv.Atoi(partKey)
+				if err != nil || part < 1 || part > 2 {
+					return nil, fmt.Errorf("member %d day %d: invalid part %q", rm.ID, day, partKey)
+				}
+				stars[part-1] = Star{Time: time.Unix(star.Timestamp, 0).UTC(), Index: star.Index}
+			}
+			m.Completed[day] = stars
+		}
+
+		lb.Members = append(lb.Members, m)
+	}
+
+	slices.SortFunc(lb.Members, func(a, b Member) int { return cmp.Compare(a.ID, b.ID) })
+	return lb, nil
+}
+
+// Days returns every day on which anyone earned a star, in order.
+func (lb *Leaderboard) Days() []int {
+	seen := map[int]bool{}
+	for _, m := range lb.Members {
+		for day := range m.Completed {
+			seen[day] = true
+		}
+	}
+	return slices.Sorted(maps.Keys(seen))
+}
+
+// Standing is a member's place on the leaderboard.
+type Standing struct {
+	Member Member
+	Stars  int
+	Score  int
+}
+
+// Standings scores the members the way the site does and ranks them by score,
+// then stars. For every star, the first member to earn it gets one point per
+// member of the leaderboard, the second one point fewer, and so on.
+func (lb *Leaderboard) Standings() []Standing {
+	standings := make([]Standing, len(lb.Members))
+	for i, m := range lb.Members {
+		standings[i].Member = m
+	}
+
+	for _, day := range lb.Days() {
+		for part := range 2 {
+			var earned []int
+			for i, m := range lb.Members {
+				if m.Completed[day][part].Earned() {
+					earned = append(earned, i)
+				}
+			}
+
+			slices.SortFunc(earned, func(a, b int) int {
+				sa, sb := lb.Members[a].Completed[day][part], lb.Members[b].Completed[day][part]
+				switch {
+				case sa.before(sb):
+					return -1
+				case sb.before(sa):
+					return 1
+				}
+				return 0
+			})
+
+			for rank, i := range earned {
+				standings[i].Stars++
+				standings[i].Score += len(lb.Members) - rank
+			}
+		}
+	}
+
+	slices.SortStableFunc(standings, func(a, b Standing) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(b.Stars, a.Stars))
+	})
+	return standings
+}
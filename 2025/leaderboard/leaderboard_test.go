@@ -0,0 +1,221 @@
+package leaderboard
+
+import (
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"aoc/2025/client"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func load(t *testing.T) *Leaderboard {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join("testdata", "leaderboard.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+
+	lb, err := Parse(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return lb
+}
+
+func TestParse(t *testing.T) {
+	lb := load(t)
+
+	if lb.Event != 2025 || lb.OwnerID != 101 {
+		t.Errorf("got event %d owner %d want 2025 and 101", lb.Event, lb.OwnerID)
+	}
+
+	names := []string{}
+	for _, m := range lb.Members {
+		names = append(names, m.Name)
+	}
+	if got, want := strings.Join(names, ","), "alice,bob,(anonymous user #303),carol"; got != want {
+		t.Errorf("got members %s want %s", got, want)
+	}
+
+	alice := lb.Members[0]
+	if got, want := alice.Completed[1][1].Time, time.Date(2025, 12, 1, 5, 6, 40, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got day 1 part 2 at %s want %s", got, want)
+	}
+	if alice.Completed[3][1].Earned() {
+		t.Error("day 3 part 2 should not be earned")
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"not json", "<html>Please log in</html>"},
+		{"bad event", `{"event":"next year","members":{}}`},
+		{"bad day", `{"event":"2025","members":{"1":{"id":1,"completion_day_level":{"x":{}}}}}`},
+		{"bad part", `{"event":"2025","members":{"1":{"id":1,"completion_day_level":{"1":{"3":{"get_star_ts":1}}}}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.input)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestMember_Delta(t *testing.T) {
+	lb := load(t)
+
+	tests := []struct {
+		member int
+		day    int
+		want   time.Duration
+		ok     bool
+	}{
+		{0, 1, 5 * time.Minute, true},
+		{0, 2, time.Hour + 50*time.Second, true},
+		{0, 3, 0, false},
+		{1, 1, 100 * time.Second, true},
+		{3, 1, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := lb.Members[tt.member].Delta(tt.day)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("member %d day %d: got %s %t want %s %t", tt.member, tt.day, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestLeaderboard_Standings(t *testing.T) {
+	lb := load(t)
+
+	standings := lb.Standings()
+	if len(standings) != len(lb.Members) {
+		t.Fatalf("got %d standings want %d", len(standings), len(lb.Members))
+	}
+
+	// The computed scores must agree with the ones the site reported.
+	for _, s := range standings {
+		if s.Score != s.Member.LocalScore {
+			t.Errorf("%s: got score %d want %d", s.Member.Name, s.Score, s.Member.LocalScore)
+		}
+		if s.Stars != s.Member.Stars {
+			t.Errorf("%s: got %d stars want %d", s.Member.Name, s.Stars, s.Member.Stars)
+		}
+	}
+
+	if standings[0].Member.Name != "alice" || standings[3].Member.Name != "carol" {
+		t.Errorf("unexpected order %+v", standings)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	var b strings.Builder
+	if err := WriteReport(&b, load(t), 20); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	path := filepath.Join("testdata", "report.txt")
+	if *update {
+		if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if b.String() != string(want) {
+		t.Errorf("report differs from %s, run with -update to accept:\n%s", path, b.String())
+	}
+}
+
+func TestFormatDelta(t *testing.T) {
+	tests := []struct {
+		delta time.Duration
+		want  string
+	}{
+		{42 * time.Second, "42s"},
+		{5 * time.Minute, "5m"},
+		{5*time.Minute + 10*time.Second, "5m10s"},
+		{time.Hour + 50*time.Second, "1h1m"},
+		{26 * time.Hour, "26h0m"},
+	}
+
+	for _, tt := range tests {
+		if got := formatDelta(tt.delta); got != tt.want {
+			t.Errorf("%s: got %q want %q", tt.delta, got, tt.want)
+		}
+	}
+}
+
+func TestFetch(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "leaderboard.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A stand-in for the site, serving the fixture at the leaderboard's URL.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2025/leaderboard/private/view/101.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	body, err := client.New(server.URL, "secret").Leaderboard(t.Context(), 2025, 101)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lb, err := Parse(strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(lb.Members) != 4 {
+		t.Errorf("got %d members want 4", len(lb.Members))
+	}
+}
+
+func TestWriteChart(t *testing.T) {
+	tests := []struct {
+		name  string
+		bars  []Bar
+		width int
+		want  string
+	}{
+		{"scaled", []Bar{{"a", 4}, {"bb", 2}}, 4, "a  |#### 4\nbb |## 2\n"},
+		{"negative width", []Bar{{"a", 4}}, -1, "a | 4\n"},
+		{"negative value", []Bar{{"a", 4}, {"b", -2}}, 4, "a |#### 4\nb | -2\n"},
+		{"empty", nil, 4, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			WriteChart(&b, tt.bars, tt.width)
+
+			if b.String() != tt.want {
+				t.Errorf("got %q want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
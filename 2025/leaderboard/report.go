@@ -0,0 +1,158 @@
+package leaderboard
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// WriteReport writes the standings, a chart of the scores, a chart of how
+// many members finished each day and the part two deltas, with bars at most
+// width characters long.
+func WriteReport(w io.Writer, lb *Leaderboard, width int) error {
+	standings := lb.Standings()
+
+	fmt.Fprintf(w, "Advent of Code %d private leaderboard, %d members\n\n", lb.Event, len(lb.Members))
+	if err := WriteStandings(w, lb, standings); err != nil {
+		return err
+	}
+
+	scores := make([]Bar, len(standings))
+	for i, s := range standings {
+		scores[i] = Bar{Label: s.Member.Name, Value: s.Score}
+	}
+	fmt.Fprintln(w, "\nLocal score")
+	WriteChart(w, scores, width)
+
+	var finished []Bar
+	for _, day := range lb.Days() {
+		count := 0
+		for _, m := range lb.Members {
+			if _, ok := m.Delta(day); ok {
+				count++
+			}
+		}
+		finished = append(finished, Bar{Label: fmt.Sprintf("Day %d", day), Value: count})
+	}
+	fmt.Fprintln(w, "\nMembers with both stars")
+	WriteChart(w, finished, width)
+
+	fmt.Fprintln(w, "\nTime from part one to part two")
+	return WriteDeltas(w, lb)
+}
+
+// WriteStandings writes a table of the standings with a strip of each
+// member's days: * for both stars, + for part one only and . for none.
+func WriteStandings(w io.Writer, lb *Leaderboard, standings []Standing) error {
+	days := lb.Days()
+	last := 0
+	if len(days) > 0 {
+		last = days[len(days)-1]
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "Rank\tScore\tStars\t %s\t\n", ruler(last))
+
+	for i, s := range standings {
+		var strip strings.Builder
+		for day := 1; day <= last; day++ {
+			stars := s.Member.Completed[day]
+			switch {
+			case stars[1].Earned():
+				strip.WriteByte('*')
+			case stars[0].Earned():
+				strip.WriteByte('+')
+			default:
+				strip.WriteByte('.')
+			}
+		}
+		fmt.Fprintf(tw, "%d)\t%d\t%d\t %s\t %s\n", i+1, s.Score, s.Stars, strip.String(), s.Member.Name)
+	}
+
+	return tw.Flush()
+}
+
+// ruler labels the columns of the day strip with the last digit of each day.
+func ruler(days int) string {
+	var b strings.Builder
+	for day := 1; day <= days; day++ {
+		b.WriteByte(byte('0' + day%10))
+	}
+	return b.String()
+}
+
+// WriteDeltas writes a table with a row per member who finished a day and a
+// column per day, holding the time each took from part one to part two.
+func WriteDeltas(w io.Writer, lb *Leaderboard) error {
+	days := lb.Days()
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprint(tw, "Member\t")
+	for _, day := range days {
+		fmt.Fprintf(tw, "%d\t", day)
+	}
+	fmt.Fprintln(tw)
+
+	for _, m := range lb.Members {
+		cells := make([]string, len(days))
+		finished := false
+		for i, day := range days {
+			cells[i] = "-"
+			if delta, ok := m.Delta(day); ok {
+				cells[i] = formatDelta(delta)
+				finished = true
+			}
+		}
+		if !finished {
+			continue
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t\n", m.Name, strings.Join(cells, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// formatDelta shortens a delta to whole seconds, or to whole minutes from an
+// hour on, dropping a trailing zero seconds.
+func formatDelta(d time.Duration) string {
+	if d >= time.Hour {
+		d = d.Round(time.Minute)
+	}
+	s := d.Round(time.Second).String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	return s
+}
+
+// Bar is one labelled value of a chart.
+type Bar struct {
+	Label string
+	Value int
+}
+
+// WriteChart draws bars as rows of # scaled so the largest value is width
+// characters long. Negative values and widths draw no bar.
+func WriteChart(w io.Writer, bars []Bar, width int) {
+	if len(bars) == 0 {
+		return
+	}
+
+	labelWidth := 0
+	for _, b := range bars {
+		labelWidth = max(labelWidth, len(b.Label))
+	}
+	largest := slices.MaxFunc(bars, func(a, b Bar) int { return a.Value - b.Value }).Value
+
+	for _, b := range bars {
+		length := 0
+		if largest > 0 {
+			length = max(b.Value*width/largest, 0)
+		}
+		fmt.Fprintf(w, "%-*s |%s %d\n", labelWidth, b.Label, strings.Repeat("#", length), b.Value)
+	}
+}
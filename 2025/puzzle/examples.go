@@ -0,0 +1,108 @@
+package puzzle
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Examples holds the example input and expected answers found in a puzzle
+// description. Answers are empty when a part has not been published or no
+// emphasized answer could be found.
+type Examples struct {
+	Input   string
+	Answers [2]string
+}
+
+var (
+	partTwoPattern        = regexp.MustCompile(`(?m)^(\\?--- Part Two ---|## Part Two)\s*$`)
+	fencePattern          = regexp.MustCompile("(?s)```[^\n]*\n(.*?)```")
+	emphasizedCodePattern = regexp.MustCompile("\\*`([^`]+)`\\*|`\\*([^`*]+)\\*`")
+)
+
+// ExamplesFromHTML extracts the examples from a cached puzzle page. The first
+// <pre><code> block is taken as the example input and the last emphasized
+// code span of each part as that part's expected answer.
+func ExamplesFromHTML(page []byte) (Examples, error) {
+	root, err := parseMain(page)
+	if err != nil {
+		return Examples{}, err
+	}
+
+	examples := Examples{}
+	part := 0
+
+	walkPage(root, func(n *node) {
+		if n.tag != "article" || part >= len(examples.Answers) {
+			return
+		}
+
+		blocks, answers := collectExamples(n)
+		if examples.Input == "" && len(blocks) > 0 {
+			examples.Input = blocks[0]
+		}
+		if len(answers) > 0 {
+			examples.Answers[part] = answers[len(answers)-1]
+		}
+
+		part++
+	})
+
+	return examples, nil
+}
+
+// ExamplesFromMarkdown extracts the examples from a puzzle.md file, accepting
+// both the output of Markdown and the older aoc-cli conversion.
+func ExamplesFromMarkdown(doc []byte) Examples {
+	sections := partTwoPattern.Split(string(doc), 2)
+	examples := Examples{}
+
+	for part, section := range sections {
+		if match := fencePattern.FindStringSubmatch(section); match != nil && examples.Input == "" {
+			examples.Input = strings.TrimRight(match[1], "\n")
+		}
+
+		answers := emphasizedCodePattern.FindAllStringSubmatch(section, -1)
+		if len(answers) == 0 {
+			continue
+		}
+
+		last := answers[len(answers)-1]
+		examples.Answers[part] = last[1] + last[2]
+	}
+
+	return examples
+}
+
+func collectExamples(n *node) ([]string, []string) {
+	blocks := []string{}
+	answers := []string{}
+
+	var visit func(*node)
+	visit = func(n *node) {
+		switch {
+		case n.tag == "pre":
+			blocks = append(blocks, strings.TrimRight(n.textContent(), "\n"))
+			return
+		case (n.tag == "code" || n.tag == "em") && isEmphasizedCode(n):
+			answers = append(answers, strings.TrimSpace(n.textContent()))
+			return
+		}
+
+		for _, child := range n.elements() {
+			visit(child)
+		}
+	}
+	visit(n)
+
+	return blocks, answers
+}
+
+// isEmphasizedCode matches <code><em>x</em></code> and <em><code>x</code></em>.
+func isEmphasizedCode(n *node) bool {
+	if len(n.children) != 1 {
+		return false
+	}
+
+	child := n.children[0]
+	return (n.tag == "code" && child.tag == "em") || (n.tag == "em" && child.tag == "code")
+}
@@ -0,0 +1,57 @@
+package puzzle
+
+import (
+	"os"
+	"testing"
+)
+
+func TestExamplesFromHTML(t *testing.T) {
+	page, err := os.ReadFile("testdata/day01.html")
+	if err != nil {
+		t.Fatalf("failed to read page: %v", err)
+	}
+
+	got, err := ExamplesFromHTML(page)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := Examples{Input: "L68\nL30\nR48", Answers: [2]string{"3", "6"}}
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestExamplesFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want Examples
+	}{
+		{
+			name: "converted markdown",
+			doc:  "# Day 1\n\n## Part One\n\n```\n1\n2\n```\n\nis *`3`*.\n\n## Part Two\n\nnow *`4`* or *`5`*.\n",
+			want: Examples{Input: "1\n2", Answers: [2]string{"3", "5"}},
+		},
+		{
+			name: "legacy markdown",
+			doc:  "\\--- Day 1 ---\n----------\n\n```\n1\n2\n\n```\n\nis `*3*`.\n\n\\--- Part Two ---\n----------\n\nnow `*7*`.\n",
+			want: Examples{Input: "1\n2", Answers: [2]string{"3", "7"}},
+		},
+		{
+			name: "part one only",
+			doc:  "## Part One\n\n```\nx\n```\n\nYour puzzle answer was `9`, example *`1`*.\n",
+			want: Examples{Input: "x", Answers: [2]string{"1", ""}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExamplesFromMarkdown([]byte(tt.doc))
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package puzzle
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// node is a minimal HTML element tree. Text nodes have an empty tag.
+type node struct {
+	tag      string
+	attrs    map[string]string
+	text     string
+	children []*node
+}
+
+var mainPattern = regexp.MustCompile(`(?s)<main>(.*)</main>`)
+
+// parseMain parses the <main> element of an Advent of Code page. The rest of
+// the page (header, sidebar, scripts) never holds puzzle content.
+func parseMain(page []byte) (*node, error) {
+	fragment := string(page)
+	if match := mainPattern.FindStringSubmatch(fragment); match != nil {
+		fragment = match[1]
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(fragment))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	root := &node{tag: "main"}
+	stack := []*node{root}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &node{tag: strings.ToLower(t.Name.Local), attrs: map[string]string{}}
+			for _, attr := range t.Attr {
+				element.attrs[strings.ToLower(attr.Name.Local)] = attr.Value
+			}
+			parent.children = append(parent.children, element)
+			stack = append(stack, element)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.children = append(parent.children, &node{text: string(t)})
+		}
+	}
+
+	return root, nil
+}
+
+// hasClass reports whether the element's class attribute contains class.
+func (n *node) hasClass(class string) bool {
+	return strings.Contains(" "+n.attrs["class"]+" ", " "+class+" ")
+}
+
+// textContent returns the concatenated text of the node and its descendants.
+func (n *node) textContent() string {
+	if n.tag == "" {
+		return n.text
+	}
+
+	var b strings.Builder
+	for _, child := range n.children {
+		b.WriteString(child.textContent())
+	}
+	return b.String()
+}
+
+// elements returns the element children of the node, skipping text.
+func (n *node) elements() []*node {
+	elements := []*node{}
+	for _, child := range n.children {
+		if child.tag != "" {
+			elements = append(elements, child)
+		}
+	}
+	return elements
+}
@@ -0,0 +1,154 @@
+package puzzle
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// BaseURL is used to absolutize relative links found in puzzle pages.
+const BaseURL = "https://adventofcode.com"
+
+var (
+	whitespacePattern = regexp.MustCompile(`\s+`)
+	markdownEscaper   = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+)
+
+// Markdown converts the puzzle descriptions of a cached Advent of Code page
+// into Markdown. Only the day-desc articles and their recorded answers are
+// kept; navigation, the answer form and the share footer are dropped.
+func Markdown(page []byte) (string, error) {
+	root, err := parseMain(page)
+	if err != nil {
+		return "", err
+	}
+
+	blocks := []string{}
+	walkPage(root, func(n *node) {
+		if n.tag == "article" {
+			blocks = append(blocks, articleBlocks(n)...)
+			return
+		}
+
+		blocks = append(blocks, inline(n))
+	})
+
+	if len(blocks) == 0 {
+		return "", nil
+	}
+
+	return strings.Join(blocks, "\n\n") + "\n", nil
+}
+
+// walkPage visits the day-desc articles and the "Your puzzle answer was"
+// paragraphs in document order.
+func walkPage(n *node, visit func(*node)) {
+	for _, child := range n.elements() {
+		switch {
+		case child.tag == "article" && child.hasClass("day-desc"):
+			visit(child)
+		case child.tag == "p" && strings.HasPrefix(strings.TrimSpace(child.textContent()), "Your puzzle answer was"):
+			visit(child)
+		default:
+			walkPage(child, visit)
+		}
+	}
+}
+
+func articleBlocks(article *node) []string {
+	blocks := []string{}
+
+	for _, child := range article.elements() {
+		switch child.tag {
+		case "h2":
+			title := strings.Trim(strings.TrimSpace(child.textContent()), "- ")
+			if strings.HasPrefix(title, "Day ") {
+				blocks = append(blocks, "# "+title, "## Part One")
+				continue
+			}
+			blocks = append(blocks, "## "+title)
+		case "pre":
+			code := strings.TrimRight(child.textContent(), "\n")
+			blocks = append(blocks, "```\n"+code+"\n```")
+		case "ul", "ol":
+			blocks = append(blocks, list(child))
+		default:
+			if block := inline(child); block != "" {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	return blocks
+}
+
+func list(n *node) string {
+	items := []string{}
+	for i, item := range n.elements() {
+		marker := "- "
+		if n.tag == "ol" {
+			marker = strconv.Itoa(i+1) + ". "
+		}
+		items = append(items, marker+inline(item))
+	}
+	return strings.Join(items, "\n")
+}
+
+// inline renders the children of n as a single line of Markdown.
+func inline(n *node) string {
+	var b strings.Builder
+	for _, child := range n.children {
+		b.WriteString(inlineNode(child))
+	}
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(b.String(), " "))
+}
+
+func inlineNode(n *node) string {
+	switch n.tag {
+	case "":
+		return markdownEscaper.Replace(n.text)
+	case "em", "strong", "b", "i":
+		content := inline(n)
+		if content == "" {
+			return ""
+		}
+		return "*" + content + "*"
+	case "code":
+		code := codeSpan(n.textContent())
+		if elements := n.elements(); len(elements) == 1 && elements[0].tag == "em" && len(n.children) == 1 {
+			return "*" + code + "*"
+		}
+		return code
+	case "a":
+		content := inline(n)
+		href := n.attrs["href"]
+		if href == "" || strings.HasPrefix(href, "javascript:") {
+			return content
+		}
+		return "[" + content + "](" + absoluteURL(href) + ")"
+	default:
+		var b strings.Builder
+		for _, child := range n.children {
+			b.WriteString(inlineNode(child))
+		}
+		return b.String()
+	}
+}
+
+func codeSpan(code string) string {
+	if strings.Contains(code, "`") {
+		return "`` " + code + " ``"
+	}
+	return "`" + code + "`"
+}
+
+func absoluteURL(href string) string {
+	switch {
+	case strings.Contains(href, "://"):
+		return href
+	case strings.HasPrefix(href, "/"):
+		return BaseURL + href
+	default:
+		return href
+	}
+}
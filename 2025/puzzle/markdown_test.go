@@ -0,0 +1,79 @@
+package puzzle
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMarkdown_Page(t *testing.T) {
+	page, err := os.ReadFile("testdata/day01.html")
+	if err != nil {
+		t.Fatalf("failed to read page: %v", err)
+	}
+
+	want, err := os.ReadFile("testdata/day01.md")
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+
+	got, err := Markdown(page)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got != string(want) {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMarkdown_Inline(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{
+			name: "escapes markdown characters",
+			html: `<article class="day-desc"><p>Use * and _ and [x]</p></article>`,
+			want: "Use \\* and \\_ and \\[x\\]\n",
+		},
+		{
+			name: "emphasized code",
+			html: `<article class="day-desc"><p>is <code><em>42</em></code>.</p></article>`,
+			want: "is *`42`*.\n",
+		},
+		{
+			name: "partially emphasized code",
+			html: `<article class="day-desc"><p><code>1<em>2</em>3</code></p></article>`,
+			want: "`123`\n",
+		},
+		{
+			name: "relative link",
+			html: `<article class="day-desc"><p><a href="/2025/about">about</a></p></article>`,
+			want: "[about](https://adventofcode.com/2025/about)\n",
+		},
+		{
+			name: "script link",
+			html: `<article class="day-desc"><p><a href="javascript:void(0);">Mastodon</a></p></article>`,
+			want: "Mastodon\n",
+		},
+		{
+			name: "outside article",
+			html: `<main><p>You can also share this puzzle.</p></main>`,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Markdown([]byte(tt.html))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package puzzle
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	atxPattern     = regexp.MustCompile(`^(#{1,6}) +(.*?)#*$`)
+	setextPattern  = regexp.MustCompile(`^(=+|-+)\s*$`)
+	bulletPattern  = regexp.MustCompile(`^[-*+] +(.*)$`)
+	orderedPattern = regexp.MustCompile(`^\d+[.)] +(.*)$`)
+)
+
+// HTML renders the Markdown of a puzzle.md file as an HTML fragment. It
+// understands what Markdown produces and what hand-written puzzle files use:
+// headings, paragraphs, lists, fenced code, emphasis, code spans, links and
+// backslash escapes. Everything else is shown as text.
+func HTML(markdown []byte) string {
+	var out strings.Builder
+	var paragraph []string
+	var items []string
+	listTag := ""
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			fmt.Fprintf(&out, "<p>%s</p>\n", inlineHTML(strings.Join(paragraph, " ")))
+			paragraph = nil
+		}
+		if len(items) > 0 {
+			fmt.Fprintf(&out, "<%s>\n", listTag)
+			for _, item := range items {
+				fmt.Fprintf(&out, "<li>%s</li>\n", inlineHTML(item))
+			}
+			fmt.Fprintf(&out, "</%s>\n", listTag)
+			items = nil
+		}
+	}
+
+	lines := strings.Split(strings.ReplaceAll(string(markdown), "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flush()
+			code := []string{}
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			fmt.Fprintf(&out, "<pre><code>%s</code></pre>\n", html.EscapeString(strings.Join(code, "\n")))
+		case trimmed == "":
+			flush()
+		case atxPattern.MatchString(trimmed):
+			flush()
+			match := atxPattern.FindStringSubmatch(trimmed)
+			level := len(match[1])
+			fmt.Fprintf(&out, "<h%d>%s</h%d>\n", level, inlineHTML(strings.TrimSpace(match[2])), level)
+		case strings.Trim(trimmed, "-") == "" && len(trimmed) >= 3 && len(paragraph) == 0 && len(items) == 0:
+			flush()
+			out.WriteString("<hr>\n")
+		case setextPattern.MatchString(trimmed) && len(paragraph) > 0:
+			level := 2
+			if trimmed[0] == '=' {
+				level = 1
+			}
+			heading := strings.Join(paragraph, " ")
+			paragraph = nil
+			flush()
+			fmt.Fprintf(&out, "<h%d>%s</h%d>\n", level, inlineHTML(heading), level)
+		case bulletPattern.MatchString(trimmed) && len(paragraph) == 0:
+			if listTag != "ul" {
+				flush()
+			}
+			listTag = "ul"
+			items = append(items, bulletPattern.FindStringSubmatch(trimmed)[1])
+		case orderedPattern.MatchString(trimmed) && len(paragraph) == 0:
+			if listTag != "ol" {
+				flush()
+			}
+			listTag = "ol"
+			items = append(items, orderedPattern.FindStringSubmatch(trimmed)[1])
+		case len(items) > 0:
+			// A continuation of the last list item.
+			items[len(items)-1] += " " + trimmed
+		default:
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flush()
+
+	return out.String()
+}
+
+// inlineHTML renders the inline Markdown of one block.
+func inlineHTML(text string) string {
+	var out strings.Builder
+	em, strong := false, false
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		switch {
+		case c == '\\' && i+1 < len(text) && strings.IndexByte("\\`*_{}[]()#+-.!<>|~", text[i+1]) >= 0:
+			i++
+			out.WriteString(html.EscapeString(text[i : i+1]))
+		case c == '`':
+			ticks := 1
+			for i+ticks < len(text) && text[i+ticks] == '`' {
+				ticks++
+			}
+
+			fence := strings.Repeat("`", ticks)
+			end := strings.Index(text[i+ticks:], fence)
+			if end < 0 {
+				out.WriteString(fence)
+				i += ticks - 1
+				continue
+			}
+
+			code := text[i+ticks : i+ticks+end]
+			if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' {
+				code = code[1 : len(code)-1]
+			}
+			fmt.Fprintf(&out, "<code>%s</code>", html.EscapeString(code))
+			i += ticks + end + ticks - 1
+		case c == '*' && strings.HasPrefix(text[i:], "**"):
+			toggle(&out, "strong", &strong)
+			i++
+		case c == '*':
+			toggle(&out, "em", &em)
+		case c == '[':
+			label, href, n, ok := link(text[i:])
+			if !ok {
+				out.WriteString("[")
+				continue
+			}
+
+			if safeURL(href) {
+				fmt.Fprintf(&out, `<a href="%s">%s</a>`, html.EscapeString(href), inlineHTML(label))
+			} else {
+				out.WriteString(inlineHTML(label))
+			}
+			i += n - 1
+		default:
+			out.WriteString(html.EscapeString(text[i : i+1]))
+		}
+	}
+
+	if em {
+		toggle(&out, "em", &em)
+	}
+	if strong {
+		toggle(&out, "strong", &strong)
+	}
+
+	return out.String()
+}
+
+// toggle opens or closes the tag and flips open.
+func toggle(out *strings.Builder, tag string, open *bool) {
+	if *open {
+		fmt.Fprintf(out, "</%s>", tag)
+	} else {
+		fmt.Fprintf(out, "<%s>", tag)
+	}
+	*open = !*open
+}
+
+// safeURL accepts web links and relative ones, but not javascript: and other
+// schemes a rendered page should not follow.
+func safeURL(href string) bool {
+	scheme, _, found := strings.Cut(href, ":")
+	if !found || strings.ContainsAny(scheme, "/?#") {
+		return true
+	}
+	scheme = strings.ToLower(scheme)
+	return scheme == "http" || scheme == "https" || scheme == "mailto"
+}
+
+// link parses a [label](href) link at the start of text and returns its
+// length.
+func link(text string) (label, href string, n int, ok bool) {
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth > 0 {
+				continue
+			}
+
+			if !strings.HasPrefix(text[i+1:], "(") {
+				return "", "", 0, false
+			}
+
+			// The URL may hold balanced parentheses, as Wikipedia links do.
+			parens := 1
+			for end := i + 2; end < len(text); end++ {
+				switch text[end] {
+				case '(':
+					parens++
+				case ')':
+					parens--
+				}
+
+				if parens == 0 {
+					return text[1:i], text[i+2 : end], end + 1, true
+				}
+			}
+
+			return "", "", 0, false
+		}
+	}
+
+	return "", "", 0, false
+}
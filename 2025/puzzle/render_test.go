@@ -0,0 +1,53 @@
+package puzzle
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		md   string
+		want string
+	}{
+		{"atx heading", "# Day 1: Secret Entrance\n", "<h1>Day 1: Secret Entrance</h1>\n"},
+		{"setext heading", "\\--- Day 1 ---\n----------\n", "<h2>--- Day 1 ---</h2>\n"},
+		{"paragraphs", "one\ntwo\n\nthree\n", "<p>one two</p>\n<p>three</p>\n"},
+		{"bullets", "* a\n* `b`\n", "<ul>\n<li>a</li>\n<li><code>b</code></li>\n</ul>\n"},
+		{"ordered", "1. a\n2. b\n", "<ol>\n<li>a</li>\n<li>b</li>\n</ol>\n"},
+		{"fence", "```\nL68\n<R48>\n```\n", "<pre><code>L68\n&lt;R48&gt;</code></pre>\n"},
+		{"emphasis", "a *different* **emergency**", "<p>a <em>different</em> <strong>emergency</strong></p>\n"},
+		{"emphasized code", "*`0`*", "<p><em><code>0</code></em></p>\n"},
+		{"code keeps stars", "`*0*`", "<p><code>*0*</code></p>\n"},
+		{"double backticks", "`` a`b ``", "<p><code>a`b</code></p>\n"},
+		{"escapes", `\*not em\* \[x\] a<b`, "<p>*not em* [x] a&lt;b</p>\n"},
+		{"link", "[project management](https://en.wikipedia.org/wiki/Project_management)!", `<p><a href="https://en.wikipedia.org/wiki/Project_management">project management</a>!</p>` + "\n"},
+		{"unsafe link", "[click](javascript:alert(1))", "<p>click</p>\n"},
+		{"unclosed emphasis", "*open", "<p><em>open</em></p>\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HTML([]byte(tt.md)); got != tt.want {
+				t.Errorf("got %q want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTML_Page(t *testing.T) {
+	doc, err := os.ReadFile("testdata/day01.md")
+	if err != nil {
+		t.Fatalf("failed to read page: %v", err)
+	}
+
+	got := HTML(doc)
+
+	for _, want := range []string{"<h1>Day 1: Secret Entrance</h1>", "<h2>Part Two</h2>", "<pre><code>L68\n", "<li>"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("rendered page is missing %q:\n%s", want, got)
+		}
+	}
+}
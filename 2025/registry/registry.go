@@ -0,0 +1,103 @@
+// Package registry collects the solution for each day so that tooling can run
+// them in-process. Days register themselves from an init function; import
+// aoc/2025/days to load all of them.
+package registry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"sync"
+)
+
+// Year is the Advent of Code event these solutions belong to.
+const Year = 2025
+
+// Part solves one half of a day's puzzle for the given input. Long running
+// parts stop with the context's error once ctx is done.
+type Part func(ctx context.Context, input io.Reader) (int, error)
+
+// Generator returns a random input of about size items (lines, ranges or grid
+// rows, depending on the day) in the day's input format. The same rng state
+// always produces the same input.
+type Generator func(rng *rand.Rand, size int) string
+
+// Day describes a registered solution. PartTwo is nil until it is solved and
+// Generate is nil for days without an input generator.
+type Day struct {
+	Day      int
+	PartOne  Part
+	PartTwo  Part
+	Generate Generator
+}
+
+// Input returns the path of the day's puzzle input relative to the module root.
+func (d Day) Input() string {
+	return fmt.Sprintf("day%02d/input", d.Day)
+}
+
+// Part returns the solver for part 1 or 2.
+func (d Day) Part(part int) (Part, error) {
+	var solver Part
+
+	switch part {
+	case 1:
+		solver = d.PartOne
+	case 2:
+		solver = d.PartTwo
+	default:
+		return nil, fmt.Errorf("day %d has no part %d", d.Day, part)
+	}
+
+	if solver == nil {
+		return nil, fmt.Errorf("day %d part %d is not solved yet", d.Day, part)
+	}
+
+	return solver, nil
+}
+
+var (
+	mu   sync.RWMutex
+	days = map[int]Day{}
+)
+
+// Register makes a day available to Lookup and All. It panics if the day is
+// registered twice.
+func Register(d Day) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := days[d.Day]; ok {
+		panic(fmt.Sprintf("registry: day %d registered twice", d.Day))
+	}
+
+	days[d.Day] = d
+}
+
+// Lookup returns the registered solution for day.
+func Lookup(day int) (Day, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	d, ok := days[day]
+	return d, ok
+}
+
+// All returns every registered day in order.
+func All() []Day {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	all := make([]Day, 0, len(days))
+	for _, d := range days {
+		all = append(all, d)
+	}
+
+	slices.SortFunc(all, func(a, b Day) int {
+		return a.Day - b.Day
+	})
+
+	return all
+}
@@ -0,0 +1,86 @@
+package registry
+
+import (
+	"context"
+	"io"
+	"testing"
+)
+
+func reset(t *testing.T) {
+	t.Helper()
+
+	mu.Lock()
+	saved := days
+	days = map[int]Day{}
+	mu.Unlock()
+
+	t.Cleanup(func() {
+		mu.Lock()
+		days = saved
+		mu.Unlock()
+	})
+}
+
+func TestRegister_LookupAndAll(t *testing.T) {
+	reset(t)
+
+	Register(Day{Day: 3, PartOne: func(context.Context, io.Reader) (int, error) { return 3, nil }})
+	Register(Day{Day: 1, PartOne: func(context.Context, io.Reader) (int, error) { return 1, nil }})
+
+	d, ok := Lookup(3)
+	if !ok {
+		t.Fatal("expected day 3 to be registered")
+	}
+
+	if got, _ := d.PartOne(t.Context(), nil); got != 3 {
+		t.Errorf("got %d want %d", got, 3)
+	}
+
+	if _, ok := Lookup(2); ok {
+		t.Error("expected day 2 to be missing")
+	}
+
+	all := All()
+	if len(all) != 2 || all[0].Day != 1 || all[1].Day != 3 {
+		t.Errorf("got %v, want days 1 and 3 in order", all)
+	}
+}
+
+func TestRegister_Duplicate(t *testing.T) {
+	reset(t)
+
+	Register(Day{Day: 1})
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected duplicate registration to panic")
+		}
+	}()
+
+	Register(Day{Day: 1})
+}
+
+func TestDay_Part(t *testing.T) {
+	d := Day{Day: 9, PartOne: func(context.Context, io.Reader) (int, error) { return 1, nil }}
+
+	if _, err := d.Part(1); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := d.Part(2); err == nil {
+		t.Error("expected error for unsolved part two")
+	}
+
+	if _, err := d.Part(3); err == nil {
+		t.Error("expected error for part three")
+	}
+}
+
+func TestDay_Input(t *testing.T) {
+	got := Day{Day: 7}.Input()
+	want := "day07/input"
+
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
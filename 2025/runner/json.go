@@ -0,0 +1,66 @@
+package runner
+
+import (
+	"encoding/json"
+	"io"
+
+	"aoc/2025/registry"
+)
+
+// Record is the machine-readable form of a Result. Allocs and PeakHeap are
+// process-wide figures, so they only describe the part when it ran alone.
+type Record struct {
+	Year       int    `json:"year"`
+	Day        int    `json:"day"`
+	Part       int    `json:"part"`
+	Answer     int    `json:"answer"`
+	DurationNs int64  `json:"duration_ns"`
+	Allocs     uint64 `json:"allocs"`
+	PeakHeap   uint64 `json:"peak_heap_bytes"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Records converts results into records.
+func Records(results []Result) []Record {
+	records := make([]Record, 0, len(results))
+
+	for _, r := range results {
+		record := Record{
+			Year:       registry.Year,
+			Day:        r.Day,
+			Part:       r.Part,
+			Answer:     r.Answer,
+			DurationNs: r.Duration.Nanoseconds(),
+			Allocs:     r.Allocs,
+			PeakHeap:   r.PeakHeap,
+		}
+		if r.Err != nil {
+			record.Error = r.Err.Error()
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// WriteJSON writes the results as an indented JSON array.
+func WriteJSON(w io.Writer, results []Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(Records(results))
+}
+
+// WriteNDJSON writes the results as newline-delimited JSON, one record per
+// line.
+func WriteNDJSON(w io.Writer, results []Result) error {
+	encoder := json.NewEncoder(w)
+
+	for _, record := range Records(results) {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package runner
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var jsonResults = []Result{
+	{Day: 1, Part: 1, Answer: 1165, Duration: time.Millisecond, Allocs: 12, PeakHeap: 1024},
+	{Day: 1, Part: 2, Err: errors.New("bad input")},
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b strings.Builder
+	if err := WriteJSON(&b, jsonResults); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []Record
+	if err := json.Unmarshal([]byte(b.String()), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, b.String())
+	}
+
+	want := []Record{
+		{Year: 2025, Day: 1, Part: 1, Answer: 1165, DurationNs: 1e6, Allocs: 12, PeakHeap: 1024},
+		{Year: 2025, Day: 1, Part: 2, Error: "bad input"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d records, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("record %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	var b strings.Builder
+	if err := WriteNDJSON(&b, jsonResults); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != len(jsonResults) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(jsonResults), b.String())
+	}
+
+	want := `{"year":2025,"day":1,"part":1,"answer":1165,"duration_ns":1000000,"allocs":12,"peak_heap_bytes":1024}`
+	if lines[0] != want {
+		t.Errorf("got %s, want %s", lines[0], want)
+	}
+}
@@ -0,0 +1,122 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	rtrace "runtime/trace"
+)
+
+// Profile selects the profiles written around each part. Profiles cover the
+// whole process, so run a single worker to keep parts apart.
+type Profile struct {
+	// Dir is where the files are written.
+	Dir string
+	// CPU writes a CPU profile.
+	CPU bool
+	// Mem writes an allocation profile.
+	Mem bool
+	// Trace writes an execution trace.
+	Trace bool
+}
+
+// Enabled reports whether any profile is selected.
+func (p Profile) Enabled() bool {
+	return p.CPU || p.Mem || p.Trace
+}
+
+// Path returns the file a part's profile of the given kind, "cpu", "mem" or
+// "trace", is written to, such as day08-part1.cpu.pprof.
+func (p Profile) Path(day, part int, kind string) string {
+	ext := ".pprof"
+	if kind == "trace" {
+		ext = ".out"
+	}
+	return filepath.Join(p.Dir, fmt.Sprintf("day%02d-part%d.%s%s", day, part, kind, ext))
+}
+
+// start begins the CPU profile and execution trace of a part. The returned
+// stop ends them, writes the allocation profile and returns the files that
+// were written completely, by kind.
+func (p Profile) start(day, part int) (stop func() (map[string]string, error), err error) {
+	type stopper struct {
+		kind string
+		stop func() error
+	}
+	var stops []stopper
+
+	stop = func() (map[string]string, error) {
+		var written map[string]string
+		var errs []error
+		for _, s := range stops {
+			if err := s.stop(); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			if written == nil {
+				written = map[string]string{}
+			}
+			written[s.kind] = p.Path(day, part, s.kind)
+		}
+		return written, errors.Join(errs...)
+	}
+
+	// abort stops what was started when a later profile fails to start.
+	abort := func(err error) error {
+		_, stopErr := stop()
+		return errors.Join(err, stopErr)
+	}
+
+	if p.CPU {
+		f, err := os.Create(p.Path(day, part, "cpu"))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+
+		stops = append(stops, stopper{"cpu", func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		}})
+	}
+
+	if p.Trace {
+		f, err := os.Create(p.Path(day, part, "trace"))
+		if err != nil {
+			return nil, abort(err)
+		}
+
+		if err := rtrace.Start(f); err != nil {
+			f.Close()
+			return nil, abort(err)
+		}
+
+		stops = append(stops, stopper{"trace", func() error {
+			rtrace.Stop()
+			return f.Close()
+		}})
+	}
+
+	if p.Mem {
+		stops = append(stops, stopper{"mem", func() error {
+			f, err := os.Create(p.Path(day, part, "mem"))
+			if err != nil {
+				return err
+			}
+
+			// Collect first so the profile is up to date.
+			runtime.GC()
+			return errors.Join(pprof.Lookup("allocs").WriteTo(f, 0), f.Close())
+		}})
+	}
+
+	return stop, nil
+}
@@ -0,0 +1,266 @@
+// Package runner executes registered solutions concurrently, with an optional
+// time limit for each part.
+package runner
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/metrics"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"aoc/2025/input"
+	"aoc/2025/registry"
+	"aoc/2025/trace"
+)
+
+var (
+	// ErrTimeout is reported for parts that did not finish within the timeout.
+	ErrTimeout = errors.New("timed out")
+	// ErrMemoryLimit is reported for parts whose heap grew beyond the limit.
+	ErrMemoryLimit = errors.New("heap limit exceeded")
+)
+
+const (
+	// heapMetric is sampled while a part runs to find its peak heap usage.
+	heapMetric = "/memory/classes/heap/objects:bytes"
+	// allocsMetric is read before and after a part to count its allocations.
+	allocsMetric = "/gc/heap/allocs:objects"
+)
+
+// sampleInterval is how often the heap is sampled.
+const sampleInterval = time.Millisecond
+
+// Job is one part of a day to run.
+type Job struct {
+	Day  registry.Day
+	Part int
+}
+
+// Result is the outcome of a job.
+type Result struct {
+	Day      int
+	Part     int
+	Answer   int
+	Duration time.Duration
+	PeakHeap uint64
+	Allocs   uint64
+	Err      error
+	// Profiles maps each kind of profile written for the part, "cpu", "mem"
+	// or "trace", to its file. Parts that failed before solving have none.
+	Profiles map[string]string
+}
+
+// Options configure Run.
+type Options struct {
+	// Inputs opens the input of each day.
+	Inputs *input.Resolver
+	// Workers is the number of parts solved at the same time.
+	Workers int
+	// Timeout limits each part; zero means no limit.
+	Timeout time.Duration
+	// MaxHeap aborts a part once the heap grows beyond this many bytes; zero
+	// means no limit. The heap is shared by the whole process, so run with a
+	// single worker for exact figures. The same applies to Result.Allocs.
+	MaxHeap uint64
+	// Profile writes profiles of each part.
+	Profile Profile
+}
+
+// Jobs returns a job for every solved part of days, or only for the given
+// part when it is non-zero.
+func Jobs(days []registry.Day, part int) []Job {
+	jobs := []Job{}
+
+	for _, d := range days {
+		for p := 1; p <= 2; p++ {
+			if part != 0 && p != part {
+				continue
+			}
+
+			if _, err := d.Part(p); err != nil {
+				continue
+			}
+
+			jobs = append(jobs, Job{Day: d, Part: p})
+		}
+	}
+
+	return jobs
+}
+
+// Run executes jobs on a bounded pool of workers and returns their results
+// ordered by day and part.
+func Run(ctx context.Context, jobs []Job, opts Options) []Result {
+	results := make([]Result, len(jobs))
+	work := make(chan int)
+
+	var wg sync.WaitGroup
+	for range max(1, opts.Workers) {
+		wg.Go(func() {
+			for i := range work {
+				results[i] = runJob(ctx, jobs[i], opts)
+			}
+		})
+	}
+
+	for i := range jobs {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	slices.SortFunc(results, func(a, b Result) int {
+		return cmp.Or(cmp.Compare(a.Day, b.Day), cmp.Compare(a.Part, b.Part))
+	})
+
+	return results
+}
+
+// runJob solves one part while sampling the heap. The context handed to the
+// solver is cancelled when the timeout or heap limit is hit; a solver that
+// ignores it is abandoned and its result discarded once it returns.
+func runJob(ctx context.Context, job Job, opts Options) (result Result) {
+	result = Result{Day: job.Day.Day, Part: job.Part}
+
+	solver, err := job.Day.Part(job.Part)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	if opts.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeoutCause(ctx, opts.Timeout, fmt.Errorf("%w after %s", ErrTimeout, opts.Timeout))
+		defer cancelTimeout()
+	}
+
+	in, err := opts.Inputs.Open(job.Day)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	stopProfile, err := opts.Profile.start(job.Day.Day, job.Part)
+	if err != nil {
+		in.Close()
+		result.Err = err
+		return result
+	}
+	defer func() {
+		written, err := stopProfile()
+		if err != nil {
+			result.Err = errors.Join(result.Err, fmt.Errorf("profile: %w", err))
+		}
+		result.Profiles = written
+	}()
+
+	// Mark where each part's events begin in a trace.
+	trace.Event("part", job.Day.Day, job.Part)
+
+	done := make(chan Result, 1)
+	day, part := job.Day.Day, job.Part
+	allocs := readMetric(allocsMetric)
+	start := time.Now()
+
+	// The solver's goroutine only touches its own Result, so an abandoned
+	// solver cannot race with the sampling below.
+	go func() {
+		solved := Result{Day: day, Part: part}
+		defer func() {
+			in.Close()
+			if r := recover(); r != nil {
+				solved.Err = fmt.Errorf("panic: %v", r)
+			}
+			solved.Duration = time.Since(start)
+			solved.Allocs = readMetric(allocsMetric) - allocs
+			done <- solved
+		}()
+
+		solved.Answer, solved.Err = solver(ctx, in)
+	}()
+
+	ticker := time.NewTicker(sampleInterval)
+	defer ticker.Stop()
+
+	var peak uint64
+	for {
+		select {
+		case solved := <-done:
+			result = solved
+			result.PeakHeap = max(peak, readMetric(heapMetric))
+			if result.Err != nil && ctx.Err() != nil {
+				result.Err = context.Cause(ctx)
+			}
+			return result
+		case <-ticker.C:
+			heap := readMetric(heapMetric)
+			peak = max(peak, heap)
+
+			if opts.MaxHeap > 0 && heap > opts.MaxHeap {
+				cancel(fmt.Errorf("%w: %s > %s", ErrMemoryLimit, formatBytes(heap), formatBytes(opts.MaxHeap)))
+			}
+		case <-ctx.Done():
+			result.PeakHeap = peak
+			result.Duration = time.Since(start)
+			result.Allocs = readMetric(allocsMetric) - allocs
+			result.Err = context.Cause(ctx)
+			return result
+		}
+	}
+}
+
+func readMetric(name string) uint64 {
+	samples := []metrics.Sample{{Name: name}}
+	metrics.Read(samples)
+	if samples[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return samples[0].Value.Uint64()
+}
+
+// Failed counts the results that carry an error.
+func Failed(results []Result) int {
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// WriteTable prints results as an aligned table followed by the summed
+// solver time.
+func WriteTable(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DAY\tPART\tANSWER\tTIME\tPEAK HEAP\tERROR")
+
+	total := time.Duration(0)
+	for _, r := range results {
+		total += r.Duration
+
+		answer, message := fmt.Sprint(r.Answer), ""
+		if r.Err != nil {
+			answer, message = "-", r.Err.Error()
+		}
+
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\n", r.Day, r.Part, answer, r.Duration.Round(time.Microsecond), formatBytes(r.PeakHeap), message)
+	}
+
+	fmt.Fprintf(tw, "\t\tTOTAL\t%s\t\t\n", total.Round(time.Microsecond))
+
+	return tw.Flush()
+}
+
+func formatBytes(bytes uint64) string {
+	return fmt.Sprintf("%.1fMiB", float64(bytes)/(1<<20))
+}
@@ -0,0 +1,245 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"aoc/2025/input"
+	"aoc/2025/registry"
+)
+
+func constant(answer int) registry.Part {
+	return func(context.Context, io.Reader) (int, error) { return answer, nil }
+}
+
+// devNull gives every day an empty input.
+var devNull = &input.Resolver{Override: os.DevNull}
+
+func TestJobs(t *testing.T) {
+	days := []registry.Day{
+		{Day: 1, PartOne: constant(1), PartTwo: constant(2)},
+		{Day: 9, PartOne: constant(9)},
+	}
+
+	if got := Jobs(days, 0); len(got) != 3 {
+		t.Errorf("got %d jobs, want 3", len(got))
+	}
+
+	got := Jobs(days, 2)
+	if len(got) != 1 || got[0].Day.Day != 1 || got[0].Part != 2 {
+		t.Errorf("got %+v, want only day 1 part 2", got)
+	}
+}
+
+func TestRun_SortedResults(t *testing.T) {
+	days := []registry.Day{
+		{Day: 2, PartOne: constant(21), PartTwo: constant(22)},
+		{Day: 1, PartOne: constant(11), PartTwo: constant(12)},
+	}
+
+	results := Run(t.Context(), Jobs(days, 0), Options{Inputs: devNull, Workers: 3})
+
+	want := []int{11, 12, 21, 22}
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d", len(results), len(want))
+	}
+
+	for i, r := range results {
+		if r.Answer != want[i] || r.Err != nil {
+			t.Errorf("result %d: got %+v, want answer %d", i, r, want[i])
+		}
+	}
+}
+
+func TestRun_BoundedWorkers(t *testing.T) {
+	var mu sync.Mutex
+	running, peak := 0, 0
+
+	slow := func(context.Context, io.Reader) (int, error) {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return 0, nil
+	}
+
+	days := []registry.Day{}
+	for day := 1; day <= 6; day++ {
+		days = append(days, registry.Day{Day: day, PartOne: slow, PartTwo: slow})
+	}
+
+	Run(t.Context(), Jobs(days, 0), Options{Inputs: devNull, Workers: 2})
+
+	if peak > 2 {
+		t.Errorf("got %d concurrent solvers, want at most 2", peak)
+	}
+}
+
+func TestRun_ErrorsPanicsAndTimeouts(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	days := []registry.Day{
+		{Day: 1, PartOne: func(context.Context, io.Reader) (int, error) { return 0, errors.New("bad input") }},
+		{Day: 2, PartOne: func(context.Context, io.Reader) (int, error) { panic("index out of range") }},
+		{Day: 3, PartOne: func(context.Context, io.Reader) (int, error) { <-release; return 0, nil }},
+		{Day: 4, PartOne: constant(4)},
+	}
+
+	results := Run(t.Context(), Jobs(days, 0), Options{Inputs: devNull, Workers: 4, Timeout: 20 * time.Millisecond})
+
+	if results[0].Err == nil || results[0].Err.Error() != "bad input" {
+		t.Errorf("day 1: got %v, want solver error", results[0].Err)
+	}
+
+	if results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "panic") {
+		t.Errorf("day 2: got %v, want panic error", results[1].Err)
+	}
+
+	if !errors.Is(results[2].Err, ErrTimeout) {
+		t.Errorf("day 3: got %v, want ErrTimeout", results[2].Err)
+	}
+
+	if results[3].Err != nil || results[3].Answer != 4 {
+		t.Errorf("day 4: got %+v, want answer 4", results[3])
+	}
+
+	if got := Failed(results); got != 3 {
+		t.Errorf("got %d failed, want 3", got)
+	}
+}
+
+func TestRun_CancelsSolverOnTimeout(t *testing.T) {
+	observed := make(chan error, 1)
+
+	days := []registry.Day{
+		{Day: 1, PartOne: func(ctx context.Context, _ io.Reader) (int, error) {
+			<-ctx.Done()
+			observed <- ctx.Err()
+			return 0, ctx.Err()
+		}},
+	}
+
+	results := Run(t.Context(), Jobs(days, 0), Options{Inputs: devNull, Workers: 1, Timeout: 10 * time.Millisecond})
+
+	if !errors.Is(results[0].Err, ErrTimeout) {
+		t.Errorf("got %v, want ErrTimeout", results[0].Err)
+	}
+
+	select {
+	case err := <-observed:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("solver saw %v, want deadline exceeded", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("solver was never cancelled")
+	}
+}
+
+func TestRun_HeapLimit(t *testing.T) {
+	hog := func(ctx context.Context, _ io.Reader) (int, error) {
+		chunks := [][]byte{}
+		for ctx.Err() == nil {
+			chunks = append(chunks, make([]byte, 1<<20))
+			time.Sleep(100 * time.Microsecond)
+		}
+		return len(chunks), ctx.Err()
+	}
+
+	days := []registry.Day{{Day: 1, PartOne: hog}}
+
+	results := Run(t.Context(), Jobs(days, 0), Options{Inputs: devNull, Workers: 1, Timeout: 10 * time.Second, MaxHeap: 64 << 20})
+
+	if !errors.Is(results[0].Err, ErrMemoryLimit) {
+		t.Fatalf("got %v, want ErrMemoryLimit", results[0].Err)
+	}
+
+	if results[0].PeakHeap <= 64<<20 {
+		t.Errorf("got peak heap %d, want above the limit", results[0].PeakHeap)
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	var b strings.Builder
+
+	err := WriteTable(&b, []Result{
+		{Day: 1, Part: 1, Answer: 1165, Duration: time.Millisecond, PeakHeap: 3 << 20},
+		{Day: 1, Part: 2, Err: errors.New("bad input"), Duration: 2 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"1165", "bad input", "TOTAL", "3ms", "3.0MiB"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("table is missing %q:\n%s", want, b.String())
+		}
+	}
+}
+
+func TestRun_Profile(t *testing.T) {
+	profile := Profile{Dir: t.TempDir(), CPU: true, Mem: true, Trace: true}
+	days := []registry.Day{{Day: 8, PartOne: constant(8), PartTwo: constant(16)}}
+
+	results := Run(t.Context(), Jobs(days, 0), Options{Inputs: devNull, Workers: 1, Profile: profile})
+
+	for _, r := range results {
+		if r.Err != nil {
+			t.Fatalf("part %d: unexpected error: %v", r.Part, r.Err)
+		}
+
+		for _, kind := range []string{"cpu", "mem", "trace"} {
+			if got, want := r.Profiles[kind], profile.Path(r.Day, r.Part, kind); got != want {
+				t.Errorf("part %d: got %s profile %q want %q", r.Part, kind, got, want)
+			}
+
+			info, err := os.Stat(profile.Path(r.Day, r.Part, kind))
+			if err != nil {
+				t.Errorf("part %d: %v", r.Part, err)
+			} else if info.Size() == 0 {
+				t.Errorf("part %d: %s profile is empty", r.Part, kind)
+			}
+		}
+	}
+}
+
+func TestRun_ProfileSkippedOnSetupError(t *testing.T) {
+	profile := Profile{Dir: t.TempDir(), CPU: true, Mem: true}
+	days := []registry.Day{{Day: 8, PartOne: constant(8)}}
+	missing := &input.Resolver{Override: filepath.Join(t.TempDir(), "missing")}
+
+	results := Run(t.Context(), Jobs(days, 1), Options{Inputs: missing, Workers: 1, Profile: profile})
+
+	if len(results) != 1 || results[0].Err == nil {
+		t.Fatalf("expected a missing input error, got %+v", results)
+	}
+
+	if len(results[0].Profiles) != 0 {
+		t.Errorf("got profiles %v for a part that never ran", results[0].Profiles)
+	}
+}
+
+func TestProfile_Path(t *testing.T) {
+	profile := Profile{Dir: "profiles"}
+
+	if got, want := profile.Path(8, 2, "cpu"), filepath.Join("profiles", "day08-part2.cpu.pprof"); got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+
+	if got, want := profile.Path(4, 1, "trace"), filepath.Join("profiles", "day04-part1.trace.out"); got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
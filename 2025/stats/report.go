@@ -0,0 +1,93 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// WriteMarkdown writes the days as a Markdown section for a README: a
+// summary line and a table with a row per day.
+func WriteMarkdown(w io.Writer, year int, days []Day) error {
+	stars, wrong, lines, tests := 0, 0, 0, 0
+	coverage, measured := 0.0, 0
+	for _, d := range days {
+		stars += d.Stars()
+		lines += d.Lines
+		tests += d.TestLines
+		for _, p := range d.Parts {
+			wrong += p.Wrong
+		}
+		if d.Coverage >= 0 {
+			coverage += d.Coverage
+			measured++
+		}
+	}
+
+	fmt.Fprintf(w, "## %d statistics\n\n", year)
+	fmt.Fprintf(w, "%d of %d stars, %d rejected %s, %d lines of solutions and %d lines of tests",
+		stars, 2*len(days), wrong, plural(wrong, "answer", "answers"), lines, tests)
+	if measured > 0 {
+		fmt.Fprintf(w, " with %.1f%% average coverage", coverage/float64(measured))
+	}
+	fmt.Fprint(w, ".\n\n")
+
+	fmt.Fprintln(w, "Solve times run from the puzzle's unlock to the accepted submission.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Day | Stars | Part 1 | Part 2 | Rejected | Commits | Last commit | Lines | Test lines | Coverage |")
+	fmt.Fprintln(w, "| --: | :---- | -----: | -----: | -------: | ------: | :---------- | ----: | ---------: | -------: |")
+
+	for _, d := range days {
+		last := "-"
+		if !d.LastCommit.IsZero() {
+			last = d.LastCommit.UTC().Format(time.DateOnly)
+		}
+
+		cover := "-"
+		if d.Coverage >= 0 {
+			cover = fmt.Sprintf("%.1f%%", d.Coverage)
+		}
+
+		fmt.Fprintf(w, "| %d | %s | %s | %s | %d | %d | %s | %d | %d | %s |\n",
+			d.Day, starStrip(d.Stars()), solveTime(d, year, 1), solveTime(d, year, 2),
+			d.Parts[0].Wrong+d.Parts[1].Wrong, d.Commits, last, d.Lines, d.TestLines, cover)
+	}
+
+	return nil
+}
+
+func starStrip(n int) string {
+	if n == 0 {
+		return "-"
+	}
+	return strings.Repeat("★", n)
+}
+
+// solveTime shows the time to solve a part, "solved" when the part is solved
+// but its submission was not recorded, and "-" when it is not solved.
+func solveTime(d Day, year, part int) string {
+	if took, ok := d.TimeToSolve(year, part); ok {
+		return formatDuration(took)
+	}
+	if d.Parts[part-1].Solved {
+		return "solved"
+	}
+	return "-"
+}
+
+// formatDuration shortens a duration to whole minutes, or whole seconds under
+// a minute.
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return d.Round(time.Second).String()
+	}
+	return strings.TrimSuffix(d.Round(time.Minute).String(), "0s")
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
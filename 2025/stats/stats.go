@@ -0,0 +1,199 @@
+// Package stats gathers how each day went: when its parts were solved, how
+// many answers were rejected on the way, how often the day was committed,
+// how much code it took and how much of it the tests cover.
+package stats
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoc/2025/answers"
+	"aoc/2025/submit"
+)
+
+// Day is what is known about one day.
+type Day struct {
+	Day   int
+	Parts [2]Part
+	// Commits and LastCommit come from the git history of the day's
+	// directory.
+	Commits    int
+	LastCommit time.Time
+	// Lines and TestLines count the non-blank, non-comment lines of
+	// solution.go and of the day's tests.
+	Lines     int
+	TestLines int
+	// Coverage is the percentage of statements covered by the day's tests,
+	// or -1 when it was not measured.
+	Coverage float64
+}
+
+// Part is what is known about one part of a day.
+type Part struct {
+	// Solved is set when answers.json holds an accepted answer.
+	Solved bool
+	// Accepted is when the answer was accepted, if the submission was
+	// recorded.
+	Accepted time.Time
+	// Wrong counts the rejected submissions.
+	Wrong int
+}
+
+// Stars returns the number of solved parts.
+func (d Day) Stars() int {
+	stars := 0
+	for _, p := range d.Parts {
+		if p.Solved {
+			stars++
+		}
+	}
+	return stars
+}
+
+// Unlock returns when a day's puzzle was released: midnight in the US
+// Eastern time zone, which is 05:00 UTC in December.
+func Unlock(year, day int) time.Time {
+	return time.Date(year, time.December, day, 5, 0, 0, 0, time.UTC)
+}
+
+// TimeToSolve returns how long after the unlock part was accepted, and false
+// when no accepted submission was recorded.
+func (d Day) TimeToSolve(year, part int) (time.Duration, bool) {
+	accepted := d.Parts[part-1].Accepted
+	if accepted.IsZero() {
+		return 0, false
+	}
+	return accepted.Sub(Unlock(year, d.Day)), true
+}
+
+// Record fills in the parts of d from the accepted answers and the
+// submissions ledger.
+func (d *Day) Record(year int, store answers.Store, ledger *submit.Ledger) {
+	for part := 1; part <= 2; part++ {
+		p := &d.Parts[part-1]
+		_, p.Solved = store.Get(year, d.Day, part)
+
+		for _, attempt := range ledger.For(year, d.Day, part) {
+			switch attempt.Verdict {
+			case submit.Correct:
+				if p.Accepted.IsZero() {
+					p.Accepted = attempt.Time
+				}
+			case submit.Wrong, submit.TooHigh, submit.TooLow:
+				p.Wrong++
+			}
+		}
+	}
+}
+
+// GitHistory returns the number of commits touching dir and when the last
+// one was made. Outside a git checkout it returns no commits.
+func GitHistory(ctx context.Context, dir string) (int, time.Time, error) {
+	cmd := exec.CommandContext(ctx, "git", "log", "--format=%aI", "--", filepath.Base(dir))
+	cmd.Dir = filepath.Dir(dir)
+
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, time.Time{}, nil
+	}
+
+	lines := strings.Fields(string(out))
+	if len(lines) == 0 {
+		return 0, time.Time{}, nil
+	}
+
+	// git log lists the newest commit first.
+	last, err := time.Parse(time.RFC3339, lines[0])
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return len(lines), last, nil
+}
+
+// CountLines counts the non-blank lines that are not only a comment in dir's
+// solution.go and, separately, in its tests. Generators and other helpers
+// are left out.
+func CountLines(dir string) (code, tests int, err error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, file := range files {
+		isTest := strings.HasSuffix(file, "_test.go")
+		if !isTest && filepath.Base(file) != "solution.go" {
+			continue
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		n := 0
+		inBlock := false
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+
+			switch {
+			case inBlock:
+				inBlock = !strings.Contains(line, "*/")
+			case line == "" || strings.HasPrefix(line, "//"):
+			case strings.HasPrefix(line, "/*"):
+				inBlock = !strings.Contains(line, "*/")
+			default:
+				n++
+			}
+		}
+
+		if isTest {
+			tests += n
+		} else {
+			code += n
+		}
+	}
+
+	return code, tests, nil
+}
+
+var coveragePattern = regexp.MustCompile(`(?m)^ok\s+(\S+)\s.*coverage: ([\d.]+)% of statements`)
+
+// Coverage runs go test -cover on the packages in root and returns the
+// statement coverage of each by the last element of its import path, such as
+// "day01". Packages whose tests fail are missing from the result.
+func Coverage(ctx context.Context, root string, packages []string) (map[string]float64, error) {
+	cmd := exec.CommandContext(ctx, "go", append([]string{"test", "-cover"}, packages...)...)
+	cmd.Dir = root
+
+	// A failing package makes go test exit non-zero, but the others still
+	// report their coverage.
+	out, err := cmd.Output()
+	if len(out) == 0 && err != nil {
+		return nil, fmt.Errorf("go test -cover: %w", err)
+	}
+
+	return parseCoverage(string(out)), nil
+}
+
+func parseCoverage(out string) map[string]float64 {
+	coverage := map[string]float64{}
+	for _, match := range coveragePattern.FindAllStringSubmatch(out, -1) {
+		percent, err := strconv.ParseFloat(match[2], 64)
+		if err == nil {
+			coverage[path.Base(match[1])] = percent
+		}
+	}
+	return coverage
+}
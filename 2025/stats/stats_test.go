@@ -0,0 +1,162 @@
+package stats
+
+import (
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"aoc/2025/answers"
+	"aoc/2025/submit"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestDay_Record(t *testing.T) {
+	store := answers.Store{}
+	store.Set(2025, 3, 1, "357")
+	store.Set(2025, 3, 2, "3121910778619")
+
+	unlock := Unlock(2025, 3)
+	ledger := &submit.Ledger{Attempts: []submit.Attempt{
+		{Year: 2025, Day: 3, Part: 1, Answer: "300", Verdict: submit.TooLow, Time: unlock.Add(10 * time.Minute)},
+		{Year: 2025, Day: 3, Part: 1, Answer: "357", Verdict: submit.Correct, Time: unlock.Add(12 * time.Minute)},
+		{Year: 2025, Day: 3, Part: 1, Answer: "357", Verdict: submit.AlreadySolved, Time: unlock.Add(20 * time.Minute)},
+		{Year: 2025, Day: 3, Part: 2, Answer: "1", Verdict: submit.Wrong, Time: unlock.Add(30 * time.Minute)},
+		{Year: 2025, Day: 3, Part: 2, Answer: "2", Verdict: submit.Wait, Time: unlock.Add(31 * time.Minute)},
+		{Year: 2024, Day: 3, Part: 2, Answer: "3", Verdict: submit.Wrong, Time: unlock},
+	}}
+
+	d := Day{Day: 3}
+	d.Record(2025, store, ledger)
+
+	if d.Stars() != 2 {
+		t.Errorf("got %d stars want 2", d.Stars())
+	}
+
+	if d.Parts[0].Wrong != 1 || d.Parts[1].Wrong != 1 {
+		t.Errorf("got %d and %d wrong want 1 and 1", d.Parts[0].Wrong, d.Parts[1].Wrong)
+	}
+
+	if took, ok := d.TimeToSolve(2025, 1); !ok || took != 12*time.Minute {
+		t.Errorf("got part 1 in %s %t want 12m0s", took, ok)
+	}
+
+	if _, ok := d.TimeToSolve(2025, 2); ok {
+		t.Error("part 2 has no accepted submission")
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"solution.go":      "// Package day01 solves day 1.\npackage day01\n\n/*\nA block comment.\n*/\nfunc PartOne() int {\n\treturn 1 // inline\n}\n",
+		"generate.go":      "package day01\n\n/* one line */\nvar x = 1\n",
+		"solution_test.go": "package day01\n\nimport \"testing\"\n\nfunc TestPartOne(t *testing.T) {}\n",
+		"input":            "not go\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	code, tests, err := CountLines(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if code != 4 || tests != 3 {
+		t.Errorf("got %d code and %d test lines want 4 and 3", code, tests)
+	}
+}
+
+func TestParseCoverage(t *testing.T) {
+	out := "ok  \taoc/2025/day01\t0.012s\tcoverage: 83.1% of statements\n" +
+		"--- FAIL: TestSolution (0.00s)\nFAIL\naoc/2025/day02\t0.010s\n" +
+		"ok  \taoc/2025/day03\t(cached)\tcoverage: 100.0% of statements\n" +
+		"?   \taoc/2025/days\t[no test files]\n"
+
+	got := parseCoverage(out)
+	if len(got) != 2 || got["day01"] != 83.1 || got["day03"] != 100 {
+		t.Errorf("unexpected coverage %v", got)
+	}
+}
+
+func TestGitHistory(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	root := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = root
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_DATE=2025-12-01T06:00:00Z", "GIT_COMMITTER_DATE=2025-12-01T06:00:00Z")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+
+	git("init", "-q")
+	for i, name := range []string{"day01/solution.go", "day01/solution_test.go", "day02/solution.go"} {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("package x\n"), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		git("add", name)
+		git("-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", strings.Repeat("x", i+1))
+	}
+
+	commits, last, err := GitHistory(t.Context(), filepath.Join(root, "day01"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if commits != 2 || !last.Equal(time.Date(2025, 12, 1, 6, 0, 0, 0, time.UTC)) {
+		t.Errorf("got %d commits, last %s want 2 and 2025-12-01 06:00", commits, last)
+	}
+
+	if commits, _, _ := GitHistory(t.Context(), filepath.Join(root, "day03")); commits != 0 {
+		t.Errorf("got %d commits for a missing day want 0", commits)
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	unlock := Unlock(2025, 1)
+	days := []Day{
+		{Day: 1, Parts: [2]Part{{Solved: true, Accepted: unlock.Add(7 * time.Minute), Wrong: 1}, {Solved: true, Accepted: unlock.Add(time.Hour + 90*time.Second)}},
+			Commits: 4, LastCommit: unlock.Add(2 * time.Hour), Lines: 120, TestLines: 40, Coverage: 91.25},
+		{Day: 2, Parts: [2]Part{{Solved: true}}, Commits: 2, LastCommit: unlock.Add(26 * time.Hour), Lines: 80, TestLines: 30, Coverage: -1},
+		{Day: 3, Coverage: -1},
+	}
+
+	var b strings.Builder
+	if err := WriteMarkdown(&b, 2025, days); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	path := filepath.Join("testdata", "report.md")
+	if *update {
+		if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if b.String() != string(want) {
+		t.Errorf("report differs from %s, run with -update to accept:\n%s", path, b.String())
+	}
+}
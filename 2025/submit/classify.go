@@ -0,0 +1,93 @@
+// Package submit classifies answer submissions and keeps a local record of
+// them, so answers that are already known to be wrong are never re-sent.
+package submit
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the outcome of submitting an answer.
+type Verdict string
+
+const (
+	Correct       Verdict = "correct"
+	TooHigh       Verdict = "too high"
+	TooLow        Verdict = "too low"
+	Wrong         Verdict = "wrong"
+	Wait          Verdict = "wait"
+	AlreadySolved Verdict = "already solved"
+	Unknown       Verdict = "unknown"
+)
+
+// Response is a classified answer page. Wait is set when the server asks to
+// hold off before the next submission, which also happens on wrong answers.
+type Response struct {
+	Verdict Verdict
+	Wait    time.Duration
+	Message string
+}
+
+var (
+	articlePattern    = regexp.MustCompile(`(?s)<article>(.*?)</article>`)
+	tagPattern        = regexp.MustCompile(`<[^>]*>`)
+	spacePattern      = regexp.MustCompile(`\s+`)
+	leftPattern       = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	pleaseWaitPattern = regexp.MustCompile(`(?i)please wait (\w+) minutes?`)
+)
+
+var numberWords = map[string]int{
+	"one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+}
+
+// Classify interprets the page returned after posting an answer.
+func Classify(body string) Response {
+	message := body
+	if match := articlePattern.FindStringSubmatch(body); match != nil {
+		message = match[1]
+	}
+	message = strings.TrimSpace(spacePattern.ReplaceAllString(tagPattern.ReplaceAllString(message, ""), " "))
+
+	response := Response{Verdict: Unknown, Message: message, Wait: waitDuration(message)}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		response.Verdict = Correct
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		response.Verdict = AlreadySolved
+	case strings.Contains(message, "You gave an answer too recently"):
+		response.Verdict = Wait
+	case strings.Contains(message, "That's not the right answer"):
+		switch {
+		case strings.Contains(message, "too high"):
+			response.Verdict = TooHigh
+		case strings.Contains(message, "too low"):
+			response.Verdict = TooLow
+		default:
+			response.Verdict = Wrong
+		}
+	}
+
+	return response
+}
+
+func waitDuration(message string) time.Duration {
+	if match := leftPattern.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	}
+
+	if match := pleaseWaitPattern.FindStringSubmatch(message); match != nil {
+		minutes, ok := numberWords[strings.ToLower(match[1])]
+		if !ok {
+			minutes, _ = strconv.Atoi(match[1])
+		}
+		return time.Duration(minutes) * time.Minute
+	}
+
+	return 0
+}
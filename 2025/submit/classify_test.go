@@ -0,0 +1,83 @@
+package submit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{
+			name:    "correct",
+			body:    `<main><article><p>That's the right answer!  You are <span class="day-success">one gold star</span> closer.</p></article></main>`,
+			verdict: Correct,
+		},
+		{
+			name:    "too high",
+			body:    `<article><p>That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.</p></article>`,
+			verdict: TooHigh,
+			wait:    time.Minute,
+		},
+		{
+			name:    "too low",
+			body:    `<article><p>That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.</p></article>`,
+			verdict: TooLow,
+			wait:    5 * time.Minute,
+		},
+		{
+			name:    "wrong",
+			body:    `<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data.</p></article>`,
+			verdict: Wrong,
+		},
+		{
+			name:    "wait with minutes",
+			body:    `<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 12s left to wait.</p></article>`,
+			verdict: Wait,
+			wait:    4*time.Minute + 12*time.Second,
+		},
+		{
+			name:    "wait seconds",
+			body:    `<article><p>You gave an answer too recently.  You have 39s left to wait.</p></article>`,
+			verdict: Wait,
+			wait:    39 * time.Second,
+		},
+		{
+			name:    "already solved",
+			body:    `<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`,
+			verdict: AlreadySolved,
+		},
+		{
+			name:    "unknown",
+			body:    `<html>Puzzle inputs differ by user.  Please log in to get your puzzle input.</html>`,
+			verdict: Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Classify(tt.body)
+
+			if got.Verdict != tt.verdict {
+				t.Errorf("got verdict %q, want %q (message %q)", got.Verdict, tt.verdict, got.Message)
+			}
+
+			if got.Wait != tt.wait {
+				t.Errorf("got wait %v, want %v", got.Wait, tt.wait)
+			}
+		})
+	}
+}
+
+func TestClassify_Message(t *testing.T) {
+	got := Classify("<p>ignored</p><article><p>That's the  right\nanswer!</p></article>").Message
+	want := "That's the right answer!"
+
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
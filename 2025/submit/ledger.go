@@ -0,0 +1,117 @@
+package submit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"time"
+)
+
+// DefaultPath is where submissions are recorded, relative to the module root.
+const DefaultPath = "submissions.json"
+
+// Attempt is one recorded submission.
+type Attempt struct {
+	Year    int       `json:"year"`
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// Ledger is the local history of submissions.
+type Ledger struct {
+	Attempts []Attempt `json:"attempts"`
+}
+
+var (
+	ErrSolved     = errors.New("already solved")
+	ErrKnownWrong = errors.New("answer was already rejected")
+	ErrOutOfRange = errors.New("answer is outside the recorded bounds")
+)
+
+// Load reads the ledger at path. A missing file is an empty ledger.
+func Load(path string) (*Ledger, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Ledger{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := &Ledger{}
+	if err := json.Unmarshal(data, ledger); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return ledger, nil
+}
+
+// Save writes the ledger to path.
+func (l *Ledger) Save(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Record appends an attempt to the ledger.
+func (l *Ledger) Record(attempt Attempt) {
+	l.Attempts = append(l.Attempts, attempt)
+}
+
+// For returns the recorded attempts for one part of a day.
+func (l *Ledger) For(year, day, part int) []Attempt {
+	attempts := []Attempt{}
+	for _, attempt := range l.Attempts {
+		if attempt.Year == year && attempt.Day == day && attempt.Part == part {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts
+}
+
+// Check reports why answer should not be submitted: the part is already
+// solved, the answer was rejected before, or it falls outside the bounds set
+// by earlier "too high" and "too low" verdicts.
+func (l *Ledger) Check(year, day, part int, answer string) error {
+	value, numeric := parseAnswer(answer)
+
+	for _, attempt := range l.For(year, day, part) {
+		switch attempt.Verdict {
+		case Correct:
+			return fmt.Errorf("%w: day %d part %d was accepted with %s", ErrSolved, day, part, attempt.Answer)
+		case Wrong, TooHigh, TooLow:
+			if attempt.Answer == answer {
+				return fmt.Errorf("%w: %s was %s", ErrKnownWrong, answer, attempt.Verdict)
+			}
+		}
+
+		bound, ok := parseAnswer(attempt.Answer)
+		if !numeric || !ok {
+			continue
+		}
+
+		if attempt.Verdict == TooHigh && value >= bound {
+			return fmt.Errorf("%w: %s is not below %s, which was too high", ErrOutOfRange, answer, attempt.Answer)
+		}
+
+		if attempt.Verdict == TooLow && value <= bound {
+			return fmt.Errorf("%w: %s is not above %s, which was too low", ErrOutOfRange, answer, attempt.Answer)
+		}
+	}
+
+	return nil
+}
+
+func parseAnswer(answer string) (int64, bool) {
+	value, err := strconv.ParseInt(answer, 10, 64)
+	return value, err == nil
+}
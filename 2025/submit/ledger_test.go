@@ -0,0 +1,73 @@
+package submit
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLedger_Check(t *testing.T) {
+	ledger := &Ledger{}
+	ledger.Record(Attempt{Year: 2025, Day: 1, Part: 1, Answer: "100", Verdict: TooHigh})
+	ledger.Record(Attempt{Year: 2025, Day: 1, Part: 1, Answer: "20", Verdict: TooLow})
+	ledger.Record(Attempt{Year: 2025, Day: 1, Part: 1, Answer: "50", Verdict: Wrong})
+	ledger.Record(Attempt{Year: 2025, Day: 1, Part: 1, Answer: "60", Verdict: Wait})
+	ledger.Record(Attempt{Year: 2025, Day: 2, Part: 1, Answer: "7", Verdict: Correct})
+
+	tests := []struct {
+		name   string
+		day    int
+		answer string
+		want   error
+	}{
+		{name: "inside bounds", day: 1, answer: "42", want: nil},
+		{name: "previously throttled", day: 1, answer: "60", want: nil},
+		{name: "known wrong", day: 1, answer: "50", want: ErrKnownWrong},
+		{name: "known too high", day: 1, answer: "100", want: ErrKnownWrong},
+		{name: "above too high", day: 1, answer: "150", want: ErrOutOfRange},
+		{name: "below too low", day: 1, answer: "3", want: ErrOutOfRange},
+		{name: "non-numeric", day: 1, answer: "abc", want: nil},
+		{name: "solved", day: 2, answer: "8", want: ErrSolved},
+		{name: "other day", day: 3, answer: "150", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ledger.Check(2025, tt.day, 1, tt.answer)
+
+			if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLedger_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "submissions.json")
+
+	empty, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading missing ledger: %v", err)
+	}
+
+	if len(empty.Attempts) != 0 {
+		t.Errorf("expected empty ledger, got %v", empty.Attempts)
+	}
+
+	attempt := Attempt{Year: 2025, Day: 4, Part: 2, Answer: "43", Verdict: Correct, Time: time.Date(2025, 12, 4, 5, 10, 0, 0, time.UTC)}
+	empty.Record(attempt)
+
+	if err := empty.Save(path); err != nil {
+		t.Fatalf("failed to save ledger: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("failed to load ledger: %v", err)
+	}
+
+	if len(loaded.Attempts) != 1 || loaded.Attempts[0] != attempt {
+		t.Errorf("got %v, want [%v]", loaded.Attempts, attempt)
+	}
+}
@@ -0,0 +1,33 @@
+package submit
+
+import (
+	"context"
+	"time"
+
+	"aoc/2025/client"
+)
+
+// Submit checks answer against the ledger, posts it with c and records the
+// classified response. Nothing is sent when Check refuses the answer.
+func Submit(ctx context.Context, c *client.Client, ledger *Ledger, year, day, part int, answer string) (Response, error) {
+	if err := ledger.Check(year, day, part, answer); err != nil {
+		return Response{}, err
+	}
+
+	body, err := c.Submit(ctx, year, day, part, answer)
+	if err != nil {
+		return Response{}, err
+	}
+
+	response := Classify(body)
+	ledger.Record(Attempt{
+		Year:    year,
+		Day:     day,
+		Part:    part,
+		Answer:  answer,
+		Verdict: response.Verdict,
+		Time:    time.Now().UTC(),
+	})
+
+	return response, nil
+}
@@ -0,0 +1,72 @@
+package submit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"aoc/2025/client"
+)
+
+// fakeServer answers like adventofcode.com for a puzzle whose answer is 42.
+func fakeServer(t *testing.T, posts *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*posts++
+
+		switch r.PostFormValue("answer") {
+		case "42":
+			w.Write([]byte("<article><p>That's the right answer!</p></article>"))
+		case "99":
+			w.Write([]byte("<article><p>That's not the right answer; your answer is too high.</p></article>"))
+		default:
+			w.Write([]byte("<article><p>That's not the right answer; your answer is too low.</p></article>"))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSubmit_RecordsAndRefuses(t *testing.T) {
+	posts := 0
+	c := client.New(fakeServer(t, &posts).URL, "secret")
+	ledger := &Ledger{}
+
+	steps := []struct {
+		answer  string
+		verdict Verdict
+		err     error
+		posts   int
+	}{
+		{answer: "99", verdict: TooHigh, posts: 1},
+		{answer: "99", err: ErrKnownWrong, posts: 1},
+		{answer: "120", err: ErrOutOfRange, posts: 1},
+		{answer: "10", verdict: TooLow, posts: 2},
+		{answer: "5", err: ErrOutOfRange, posts: 2},
+		{answer: "42", verdict: Correct, posts: 3},
+		{answer: "43", err: ErrSolved, posts: 3},
+	}
+
+	for _, step := range steps {
+		response, err := Submit(t.Context(), c, ledger, 2025, 1, 1, step.answer)
+
+		if !errors.Is(err, step.err) || (step.err == nil && err != nil) {
+			t.Fatalf("answer %s: got error %v, want %v", step.answer, err, step.err)
+		}
+
+		if response.Verdict != step.verdict {
+			t.Errorf("answer %s: got verdict %q, want %q", step.answer, response.Verdict, step.verdict)
+		}
+
+		if posts != step.posts {
+			t.Errorf("answer %s: server saw %d posts, want %d", step.answer, posts, step.posts)
+		}
+	}
+
+	if len(ledger.Attempts) != 3 {
+		t.Errorf("got %d recorded attempts, want 3", len(ledger.Attempts))
+	}
+}
@@ -0,0 +1,136 @@
+// Package trace records the steps of a solver as JSON lines. Solvers call
+// Event wherever they would print a debug line; nothing is recorded, or
+// formatted, until Start is called.
+package trace
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Entry is one recorded event.
+type Entry struct {
+	// Step numbers the events of a trace from 1.
+	Step int    `json:"step"`
+	Name string `json:"event"`
+	Args []any  `json:"args,omitempty"`
+}
+
+// tracer writes events to a writer.
+type tracer struct {
+	mu   sync.Mutex
+	enc  *json.Encoder
+	step int
+	err  error
+}
+
+var current atomic.Pointer[tracer]
+
+// Start records every following event to w until the returned stop is
+// called, which flushes w and reports the first write error.
+func Start(w io.Writer) (stop func() error) {
+	bw := bufio.NewWriter(w)
+	t := &tracer{enc: json.NewEncoder(bw)}
+	current.Store(t)
+
+	return func() error {
+		current.CompareAndSwap(t, nil)
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		return errors.Join(t.err, bw.Flush())
+	}
+}
+
+// Enabled reports whether events are being recorded. Guard Event with it in
+// hot loops, since passing arguments to Event costs an allocation even when
+// tracing is off.
+func Enabled() bool {
+	return current.Load() != nil
+}
+
+// Event records an event called name with args, which are written as JSON, or
+// with fmt's %v when they cannot be.
+func Event(name string, args ...any) {
+	t := current.Load()
+	if t == nil {
+		return
+	}
+
+	t.event(name, args)
+}
+
+func (t *tracer) event(name string, args []any) {
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = arg
+		if _, err := json.Marshal(arg); err != nil {
+			values[i] = fmt.Sprintf("%v", arg)
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.step++
+	if err := t.enc.Encode(Entry{Step: t.step, Name: name, Args: values}); err != nil && t.err == nil {
+		t.err = err
+	}
+}
+
+// Filter selects entries by name and step range.
+type Filter struct {
+	// Names keeps only these events; empty keeps all.
+	Names []string
+	// From and To bound the steps kept, inclusive; zero leaves that end open.
+	From int
+	To   int
+}
+
+// Match reports whether e passes the filter.
+func (f Filter) Match(e Entry) bool {
+	if len(f.Names) > 0 && !slices.Contains(f.Names, e.Name) {
+		return false
+	}
+	if f.From != 0 && e.Step < f.From {
+		return false
+	}
+	if f.To != 0 && e.Step > f.To {
+		return false
+	}
+	return true
+}
+
+// Read calls fn with each entry of a trace written by Start that matches f.
+func Read(r io.Reader, f Filter, fn func(Entry) error) error {
+	dec := json.NewDecoder(r)
+
+	for {
+		var e Entry
+		if err := dec.Decode(&e); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// Steps only grow, so nothing after To can match.
+		if f.To != 0 && e.Step > f.To {
+			return nil
+		}
+
+		if !f.Match(e) {
+			continue
+		}
+
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+}
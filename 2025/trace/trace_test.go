@@ -0,0 +1,83 @@
+package trace
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestEvent_Disabled(t *testing.T) {
+	if Enabled() {
+		t.Fatal("expected tracing to be off by default")
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { Event("merge") }); allocs != 0 {
+		t.Errorf("got %v allocations per disabled event, want 0", allocs)
+	}
+}
+
+func TestStart(t *testing.T) {
+	var b bytes.Buffer
+	stop := Start(&b)
+
+	Event("merge", 1, "a")
+	Event("line", struct{ X, Y int }{3, 4})
+	Event("func", func() {})
+
+	if err := stop(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	Event("after stop")
+
+	want := `{"step":1,"event":"merge","args":[1,"a"]}
+{"step":2,"event":"line","args":[{"X":3,"Y":4}]}
+`
+	if got := b.String(); !strings.HasPrefix(got, want) {
+		t.Errorf("got:\n%s\nwant it to start with:\n%s", got, want)
+	}
+
+	if got := strings.Count(b.String(), "\n"); got != 3 {
+		t.Errorf("got %d events want %d", got, 3)
+	}
+}
+
+func TestRead(t *testing.T) {
+	var b bytes.Buffer
+	stop := Start(&b)
+	for _, name := range []string{"line", "merge", "line", "merge", "merge", "line"} {
+		Event(name)
+	}
+	if err := stop(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []int
+	}{
+		{"all", Filter{}, []int{1, 2, 3, 4, 5, 6}},
+		{"by name", Filter{Names: []string{"merge"}}, []int{2, 4, 5}},
+		{"by range", Filter{From: 2, To: 4}, []int{2, 3, 4}},
+		{"both", Filter{Names: []string{"line"}, From: 2}, []int{3, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			err := Read(bytes.NewReader(b.Bytes()), tt.filter, func(e Entry) error {
+				got = append(got, e.Step)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got steps %v want %v", got, tt.want)
+			}
+		})
+	}
+}
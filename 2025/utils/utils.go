@@ -2,12 +2,14 @@ package utils
 
 import (
 	"bufio"
-	"os"
+	"context"
+	"io"
 )
 
-// StreamFileLines streams a file line by line through a channel.
-// It returns a channel of strings and a channel of errors.
-func StreamFileLines(path string) (<-chan string, <-chan error) {
+// StreamLines streams r line by line through a channel.
+// It returns a channel of strings and a channel of errors. Streaming stops
+// with the context's error once ctx is done.
+func StreamLines(ctx context.Context, r io.Reader) (<-chan string, <-chan error) {
 	lines := make(chan string)
 	errs := make(chan error, 1)
 
@@ -15,16 +17,19 @@ func StreamFileLines(path string) (<-chan string, <-chan error) {
 		defer close(lines)
 		defer close(errs)
 
-		file, err := os.Open(path)
-		if err != nil {
-			errs <- err
-			return
-		}
-		defer file.Close()
-
-		scanner := bufio.NewScanner(file)
+		scanner := bufio.NewScanner(r)
 		for scanner.Scan() {
-			lines <- scanner.Text()
+			if err := ctx.Err(); err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case lines <- scanner.Text():
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
 		}
 
 		if err := scanner.Err(); err != nil {
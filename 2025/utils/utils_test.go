@@ -1,22 +1,15 @@
 package utils
 
 import (
-	"os"
-	"path/filepath"
+	"context"
+	"errors"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
-func TestStreamFileLines_Success(t *testing.T) {
-	// Create a temporary file with test content
-	tmpDir := t.TempDir()
-	tmpFile := filepath.Join(tmpDir, "test.txt")
-	content := "line1\nline2\nline3"
-	err := os.WriteFile(tmpFile, []byte(content), 0644)
-	if err != nil {
-		t.Fatalf("failed to create temp file: %v", err)
-	}
-
-	lines, errs := StreamFileLines(tmpFile)
+func TestStreamLines_Success(t *testing.T) {
+	lines, errs := StreamLines(t.Context(), strings.NewReader("line1\nline2\nline3"))
 
 	var result []string
 	for line := range lines {
@@ -40,15 +33,8 @@ func TestStreamFileLines_Success(t *testing.T) {
 	}
 }
 
-func TestStreamFileLines_EmptyFile(t *testing.T) {
-	tmpDir := t.TempDir()
-	tmpFile := filepath.Join(tmpDir, "empty.txt")
-	err := os.WriteFile(tmpFile, []byte(""), 0644)
-	if err != nil {
-		t.Fatalf("failed to create temp file: %v", err)
-	}
-
-	lines, errs := StreamFileLines(tmpFile)
+func TestStreamLines_Empty(t *testing.T) {
+	lines, errs := StreamLines(t.Context(), strings.NewReader(""))
 
 	var result []string
 	for line := range lines {
@@ -64,28 +50,21 @@ func TestStreamFileLines_EmptyFile(t *testing.T) {
 	}
 }
 
-func TestStreamFileLines_FileNotFound(t *testing.T) {
-	lines, errs := StreamFileLines("/nonexistent/path/file.txt")
+func TestStreamLines_ReadError(t *testing.T) {
+	failure := errors.New("disk on fire")
+	lines, errs := StreamLines(t.Context(), iotest.ErrReader(failure))
 
 	// Drain lines channel
 	for range lines {
 	}
 
-	err := <-errs
-	if err == nil {
-		t.Error("expected error for non-existent file, got nil")
+	if err := <-errs; !errors.Is(err, failure) {
+		t.Errorf("got %v, want %v", err, failure)
 	}
 }
 
-func TestStreamFileLines_SingleLine(t *testing.T) {
-	tmpDir := t.TempDir()
-	tmpFile := filepath.Join(tmpDir, "single.txt")
-	err := os.WriteFile(tmpFile, []byte("only one line"), 0644)
-	if err != nil {
-		t.Fatalf("failed to create temp file: %v", err)
-	}
-
-	lines, errs := StreamFileLines(tmpFile)
+func TestStreamLines_SingleLine(t *testing.T) {
+	lines, errs := StreamLines(t.Context(), strings.NewReader("only one line"))
 
 	var result []string
 	for line := range lines {
@@ -104,3 +83,22 @@ func TestStreamFileLines_SingleLine(t *testing.T) {
 		t.Errorf("got %q, want %q", result[0], "only one line")
 	}
 }
+
+func TestStreamLines_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	lines, errs := StreamLines(ctx, strings.NewReader("line1\nline2\nline3"))
+
+	if line := <-lines; line != "line1" {
+		t.Fatalf("got %q, want %q", line, "line1")
+	}
+
+	cancel()
+
+	// Drain lines channel
+	for range lines {
+	}
+
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,220 @@
+package viz
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"image/color"
+	"io"
+	"math"
+)
+
+// Point3 is a position in 3D puzzle coordinates.
+type Point3 struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+// Cloud is a set of coloured 3D points joined by edges.
+type Cloud struct {
+	Title  string
+	Points []Point3
+	// Colors holds the colour of each point.
+	Colors []color.RGBA
+	// Edges join two points by index.
+	Edges [][2]int
+}
+
+// Add appends a point and returns its index.
+func (c *Cloud) Add(p Point3, col color.RGBA) int {
+	c.Points = append(c.Points, p)
+	c.Colors = append(c.Colors, col)
+	return len(c.Points) - 1
+}
+
+// Hue returns the i-th of a sequence of distinct, evenly spread colours, for
+// colouring groups such as circuits.
+func Hue(i int) color.RGBA {
+	// Stepping by the golden angle keeps consecutive hues far apart.
+	h := math.Mod(float64(i)*137.508, 360) / 60
+	x := uint8(math.Round(255 * (1 - math.Abs(math.Mod(h, 2)-1))))
+
+	switch int(h) {
+	case 0:
+		return color.RGBA{255, x, 0, 255}
+	case 1:
+		return color.RGBA{x, 255, 0, 255}
+	case 2:
+		return color.RGBA{0, 255, x, 255}
+	case 3:
+		return color.RGBA{0, x, 255, 255}
+	case 4:
+		return color.RGBA{x, 0, 255, 255}
+	}
+	return color.RGBA{255, 0, x, 255}
+}
+
+// WritePLY writes the cloud as an ASCII PLY file with per-vertex colours and
+// an edge element, which MeshLab and Blender read.
+func (c *Cloud) WritePLY(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "ply")
+	fmt.Fprintln(bw, "format ascii 1.0")
+	if c.Title != "" {
+		fmt.Fprintf(bw, "comment %s\n", c.Title)
+	}
+	fmt.Fprintf(bw, "element vertex %d\n", len(c.Points))
+	fmt.Fprintln(bw, "property float x\nproperty float y\nproperty float z")
+	fmt.Fprintln(bw, "property uchar red\nproperty uchar green\nproperty uchar blue")
+	fmt.Fprintf(bw, "element edge %d\n", len(c.Edges))
+	fmt.Fprintln(bw, "property int vertex1\nproperty int vertex2")
+	fmt.Fprintln(bw, "end_header")
+
+	for i, p := range c.Points {
+		col := c.Colors[i]
+		fmt.Fprintf(bw, "%s %s %s %d %d %d\n", num(p.X), num(p.Y), num(p.Z), col.R, col.G, col.B)
+	}
+	for _, e := range c.Edges {
+		fmt.Fprintf(bw, "%d %d\n", e[0], e[1])
+	}
+
+	return bw.Flush()
+}
+
+// WriteOBJ writes the cloud as a Wavefront OBJ file, with colours as the
+// widely read "v x y z r g b" extension and edges as lines.
+func (c *Cloud) WriteOBJ(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	if c.Title != "" {
+		fmt.Fprintf(bw, "# %s\n", c.Title)
+	}
+	for i, p := range c.Points {
+		col := c.Colors[i]
+		fmt.Fprintf(bw, "v %s %s %s %s %s %s\n", num(p.X), num(p.Y), num(p.Z),
+			num(float64(col.R)/255), num(float64(col.G)/255), num(float64(col.B)/255))
+	}
+	for _, e := range c.Edges {
+		// OBJ indices start at 1.
+		fmt.Fprintf(bw, "l %d %d\n", e[0]+1, e[1]+1)
+	}
+
+	return bw.Flush()
+}
+
+// WriteHTML writes a page that draws the cloud on a canvas with no other
+// files or network access. Drag to rotate and scroll to zoom.
+func (c *Cloud) WriteHTML(w io.Writer) error {
+	type point struct {
+		X, Y, Z float64
+		Color   string
+	}
+
+	data := struct {
+		Title  string
+		Points []point
+		Edges  [][2]int
+	}{Title: c.Title, Points: []point{}, Edges: c.Edges}
+
+	for i, p := range c.Points {
+		col := c.Colors[i]
+		data.Points = append(data.Points, point{p.X, p.Y, p.Z, fmt.Sprintf("#%02x%02x%02x", col.R, col.G, col.B)})
+	}
+	if data.Edges == nil {
+		data.Edges = [][2]int{}
+	}
+
+	return viewer.Execute(w, data)
+}
+
+var viewer = template.Must(template.New("viewer").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { margin: 0; background: #1e1e2e; color: #cdd6f4; font: 14px sans-serif; overflow: hidden; }
+#title { position: absolute; top: 8px; left: 8px; }
+</style>
+</head>
+<body>
+<div id="title">{{.Title}}</div>
+<canvas id="view"></canvas>
+<script>
+const points = {{.Points}};
+const edges = {{.Edges}};
+const canvas = document.getElementById("view");
+const ctx = canvas.getContext("2d");
+
+let center = [0, 0, 0], radius = 1;
+if (points.length > 0) {
+  const lo = [Infinity, Infinity, Infinity], hi = [-Infinity, -Infinity, -Infinity];
+  for (const p of points) {
+    [p.X, p.Y, p.Z].forEach((v, i) => { lo[i] = Math.min(lo[i], v); hi[i] = Math.max(hi[i], v); });
+  }
+  center = lo.map((v, i) => (v + hi[i]) / 2);
+  radius = Math.max(...hi.map((v, i) => v - lo[i])) / 2 || 1;
+}
+
+let yaw = 0.6, pitch = 0.4, zoom = 0.8;
+
+function project(p) {
+  const x = p.X - center[0], y = p.Y - center[1], z = p.Z - center[2];
+  const x1 = x * Math.cos(yaw) - z * Math.sin(yaw);
+  const z1 = x * Math.sin(yaw) + z * Math.cos(yaw);
+  const y1 = y * Math.cos(pitch) - z1 * Math.sin(pitch);
+  const z2 = y * Math.sin(pitch) + z1 * Math.cos(pitch);
+  const scale = zoom * Math.min(canvas.width, canvas.height) / 2 / radius;
+  return [canvas.width / 2 + x1 * scale, canvas.height / 2 + y1 * scale, z2];
+}
+
+function draw() {
+  canvas.width = window.innerWidth;
+  canvas.height = window.innerHeight;
+  ctx.clearRect(0, 0, canvas.width, canvas.height);
+
+  const projected = points.map(project);
+
+  ctx.globalAlpha = 0.6;
+  ctx.lineWidth = 1;
+  for (const [a, b] of edges) {
+    ctx.strokeStyle = points[a].Color;
+    ctx.beginPath();
+    ctx.moveTo(projected[a][0], projected[a][1]);
+    ctx.lineTo(projected[b][0], projected[b][1]);
+    ctx.stroke();
+  }
+
+  ctx.globalAlpha = 1;
+  const order = projected.map((_, i) => i).sort((a, b) => projected[b][2] - projected[a][2]);
+  for (const i of order) {
+    ctx.fillStyle = points[i].Color;
+    ctx.beginPath();
+    ctx.arc(projected[i][0], projected[i][1], 2.5, 0, 2 * Math.PI);
+    ctx.fill();
+  }
+}
+
+let dragging = null;
+canvas.addEventListener("mousedown", e => { dragging = [e.clientX, e.clientY]; });
+window.addEventListener("mouseup", () => { dragging = null; });
+window.addEventListener("mousemove", e => {
+  if (!dragging) return;
+  yaw += (e.clientX - dragging[0]) * 0.01;
+  pitch += (e.clientY - dragging[1]) * 0.01;
+  dragging = [e.clientX, e.clientY];
+  draw();
+});
+canvas.addEventListener("wheel", e => {
+  e.preventDefault();
+  zoom *= e.deltaY < 0 ? 1.1 : 1 / 1.1;
+  draw();
+});
+window.addEventListener("resize", draw);
+draw();
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,102 @@
+package viz
+
+import (
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+	"maps"
+	"slices"
+	"time"
+)
+
+// background is the colour of cells with no colour in the palette.
+var background = color.RGBA{0x1e, 0x1e, 0x2e, 0xff}
+
+// rgb is the colour each ANSI colour is drawn with in images.
+var rgb = map[Color]color.RGBA{
+	Red:     {0xf3, 0x8b, 0xa8, 0xff},
+	Green:   {0xa6, 0xe3, 0xa1, 0xff},
+	Yellow:  {0xf9, 0xe2, 0xaf, 0xff},
+	Blue:    {0x89, 0xb4, 0xfa, 0xff},
+	Magenta: {0xcb, 0xa6, 0xf7, 0xff},
+	Cyan:    {0x94, 0xe2, 0xd5, 0xff},
+	Gray:    {0x45, 0x47, 0x5a, 0xff},
+}
+
+// RGBA returns the colour c is drawn with in images.
+func (c Color) RGBA() color.RGBA {
+	if value, ok := rgb[c]; ok {
+		return value
+	}
+	return background
+}
+
+// Recorder collects the frames of a run, so they can be written once it ends.
+type Recorder struct {
+	Titles []string
+	Frames []Grid
+}
+
+// Record appends a frame. Pass it to WithHook to record a run.
+func (r *Recorder) Record(title string, g Grid) {
+	r.Titles = append(r.Titles, title)
+	r.Frames = append(r.Frames, slices.Clone(g))
+}
+
+// WriteGIF writes frames as an animated GIF, drawing each cell as a square of
+// scale pixels in the colour palette gives it. Every frame is shown for delay,
+// and the last one for a second longer so the loop's end can be seen.
+func WriteGIF(w io.Writer, frames []Grid, palette map[rune]Color, scale int, delay time.Duration) error {
+	width, height := 0, 0
+	for _, g := range frames {
+		height = max(height, len(g))
+		for _, row := range g {
+			width = max(width, len([]rune(row)))
+		}
+	}
+
+	colors := color.Palette{background}
+	index := map[rune]uint8{}
+	for _, c := range slices.Sorted(maps.Keys(palette)) {
+		rgba := palette[c].RGBA()
+		i := slices.IndexFunc(colors, func(existing color.Color) bool { return existing == color.Color(rgba) })
+		if i < 0 {
+			i = len(colors)
+			colors = append(colors, rgba)
+		}
+		index[c] = uint8(i)
+	}
+
+	anim := &gif.GIF{}
+	bounds := image.Rect(0, 0, max(width*scale, 1), max(height*scale, 1))
+	centiseconds := int(delay / (10 * time.Millisecond))
+
+	for _, g := range frames {
+		img := image.NewPaletted(bounds, colors)
+
+		for y, row := range g {
+			for x, cell := range []rune(row) {
+				i := index[cell]
+				if i == 0 {
+					continue
+				}
+
+				for py := y * scale; py < (y+1)*scale; py++ {
+					for px := x * scale; px < (x+1)*scale; px++ {
+						img.SetColorIndex(px, py, i)
+					}
+				}
+			}
+		}
+
+		anim.Image = append(anim.Image, img)
+		anim.Delay = append(anim.Delay, centiseconds)
+	}
+
+	if len(anim.Delay) > 0 {
+		anim.Delay[len(anim.Delay)-1] += 100
+	}
+
+	return gif.EncodeAll(w, anim)
+}
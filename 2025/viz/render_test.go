@@ -0,0 +1,140 @@
+package viz
+
+import (
+	"bytes"
+	"flag"
+	"image/gif"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// golden compares got with testdata/name, or rewrites it with -update.
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.MkdirAll("testdata", 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run with -update to create it): %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from the golden file; run with -update if the change is intended:\n%s", name, got)
+	}
+}
+
+func TestScene_WriteSVG(t *testing.T) {
+	s := &Scene{Title: "loop & box"}
+	loop := []Point{{7, 1}, {11, 1}, {11, 7}, {9, 7}, {9, 5}, {2, 5}, {2, 3}, {7, 3}}
+
+	s.Polygon(loop, Style{Fill: "green", Stroke: "darkgreen", Opacity: 0.4})
+	s.Rect(Rect{Min: Point{9, 5}, Max: Point{2, 3}}, Style{Fill: "gold"})
+	s.Edge(Point{7, 1}, Point{11, 7}, Style{Stroke: "blue"})
+	for _, p := range loop {
+		s.Point(p, Style{Fill: "red"})
+	}
+
+	var b bytes.Buffer
+	if err := s.WriteSVG(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	golden(t, "scene.svg", b.Bytes())
+}
+
+func TestWriteGIF(t *testing.T) {
+	frames := []Grid{
+		{"@@.", "@@@", ".@@"},
+		{"x@.", "@@x", ".@x"},
+		{".@.", "@@.", ".@."},
+	}
+
+	var b bytes.Buffer
+	if err := WriteGIF(&b, frames, DefaultPalette, 2, 100*time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	golden(t, "frames.gif", b.Bytes())
+
+	anim, err := gif.DecodeAll(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(anim.Image) != len(frames) {
+		t.Fatalf("got %d frames want %d", len(anim.Image), len(frames))
+	}
+
+	if got := anim.Image[0].Bounds().Dx(); got != 6 {
+		t.Errorf("got width %d want %d", got, 6)
+	}
+
+	if got, want := anim.Image[1].At(0, 0), Red.RGBA(); got != want {
+		t.Errorf("got %v want %v for a removed roll", got, want)
+	}
+
+	if got := anim.Delay; got[0] != 10 || got[2] != 110 {
+		t.Errorf("got delays %v, want 10 per frame and a longer last frame", got)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	var r Recorder
+	g := Grid{"ab"}
+
+	r.Record("first", g)
+	g[0] = "cd"
+
+	if r.Frames[0][0] != "ab" {
+		t.Errorf("got %q, want the frame as it was recorded", r.Frames[0][0])
+	}
+}
+
+func TestCloud_Write(t *testing.T) {
+	cloud := &Cloud{Title: "two circuits"}
+	a := cloud.Add(Point3{162, 817, 812}, Hue(0))
+	b := cloud.Add(Point3{425, 690, 689}, Hue(0))
+	cloud.Add(Point3{57, 618, 57}, Hue(1))
+	cloud.Edges = append(cloud.Edges, [2]int{a, b})
+
+	writers := map[string]func(w *bytes.Buffer) error{
+		"cloud.ply":  func(w *bytes.Buffer) error { return cloud.WritePLY(w) },
+		"cloud.obj":  func(w *bytes.Buffer) error { return cloud.WriteOBJ(w) },
+		"cloud.html": func(w *bytes.Buffer) error { return cloud.WriteHTML(w) },
+	}
+
+	for name, write := range writers {
+		t.Run(name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := write(&b); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			golden(t, name, b.Bytes())
+		})
+	}
+}
+
+func TestHue(t *testing.T) {
+	seen := map[any]bool{}
+
+	for i := range 20 {
+		if seen[Hue(i)] {
+			t.Errorf("hue %d repeats an earlier one", i)
+		}
+		seen[Hue(i)] = true
+	}
+}
@@ -0,0 +1,169 @@
+package viz
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Point is a position in puzzle coordinates, with y growing downwards.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Rect is the axis-aligned rectangle spanned by two opposite corners.
+type Rect struct {
+	Min Point
+	Max Point
+}
+
+// Style is how a shape is painted. Empty fields fall back to the SVG default.
+type Style struct {
+	Fill   string
+	Stroke string
+	// Opacity applies to the whole shape; zero means opaque.
+	Opacity float64
+}
+
+// Scene is a vector drawing in puzzle coordinates. The SVG's view box is fitted
+// to the shapes, so coordinates of any scale can be drawn as they are.
+type Scene struct {
+	// Title is written as the SVG's title.
+	Title string
+
+	shapes  []shape
+	bounded bool
+	min     Point
+	max     Point
+}
+
+type shape struct {
+	kind   string
+	points []Point
+	style  Style
+}
+
+// SceneHook receives scenes drawn by a solver.
+type SceneHook func(s *Scene)
+
+type sceneKey struct{}
+
+// WithScene returns a context that carries hook to the solvers run with it.
+func WithScene(ctx context.Context, hook SceneHook) context.Context {
+	return context.WithValue(ctx, sceneKey{}, hook)
+}
+
+// SceneFrom returns the scene hook carried by ctx, or nil.
+func SceneFrom(ctx context.Context) SceneHook {
+	hook, _ := ctx.Value(sceneKey{}).(SceneHook)
+	return hook
+}
+
+// Point adds a dot at p.
+func (s *Scene) Point(p Point, style Style) {
+	s.add(shape{"point", []Point{p}, style})
+}
+
+// Edge adds a line from a to b.
+func (s *Scene) Edge(a, b Point, style Style) {
+	s.add(shape{"edge", []Point{a, b}, style})
+}
+
+// Rect adds r.
+func (s *Scene) Rect(r Rect, style Style) {
+	s.add(shape{"rect", []Point{r.Min, r.Max}, style})
+}
+
+// Polygon adds the closed polygon through points.
+func (s *Scene) Polygon(points []Point, style Style) {
+	s.add(shape{"polygon", points, style})
+}
+
+func (s *Scene) add(sh shape) {
+	for _, p := range sh.points {
+		if !s.bounded {
+			s.min, s.max, s.bounded = p, p, true
+		}
+		s.min = Point{math.Min(s.min.X, p.X), math.Min(s.min.Y, p.Y)}
+		s.max = Point{math.Max(s.max.X, p.X), math.Max(s.max.Y, p.Y)}
+	}
+
+	s.shapes = append(s.shapes, sh)
+}
+
+// WriteSVG writes the scene as an SVG document. Strokes and dots keep the same
+// size on screen however large the coordinates are.
+func (s *Scene) WriteSVG(w io.Writer) error {
+	span := math.Max(math.Max(s.max.X-s.min.X, s.max.Y-s.min.Y), 1)
+	margin := span / 50
+	radius := span / 200
+
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="%s %s %s %s" width="800" height="800">`+"\n",
+		num(s.min.X-margin), num(s.min.Y-margin), num(s.max.X-s.min.X+2*margin), num(s.max.Y-s.min.Y+2*margin))
+	if s.Title != "" {
+		fmt.Fprintf(bw, "<title>%s</title>\n", escape(s.Title))
+	}
+
+	for _, sh := range s.shapes {
+		attrs := sh.style.attrs()
+
+		switch sh.kind {
+		case "point":
+			p := sh.points[0]
+			fmt.Fprintf(bw, `<circle cx="%s" cy="%s" r="%s"%s/>`+"\n", num(p.X), num(p.Y), num(radius), attrs)
+		case "edge":
+			a, b := sh.points[0], sh.points[1]
+			fmt.Fprintf(bw, `<line x1="%s" y1="%s" x2="%s" y2="%s"%s/>`+"\n", num(a.X), num(a.Y), num(b.X), num(b.Y), attrs)
+		case "rect":
+			a, b := sh.points[0], sh.points[1]
+			x, y := math.Min(a.X, b.X), math.Min(a.Y, b.Y)
+			fmt.Fprintf(bw, `<rect x="%s" y="%s" width="%s" height="%s"%s/>`+"\n", num(x), num(y), num(math.Abs(b.X-a.X)), num(math.Abs(b.Y-a.Y)), attrs)
+		case "polygon":
+			points := make([]string, len(sh.points))
+			for i, p := range sh.points {
+				points[i] = num(p.X) + "," + num(p.Y)
+			}
+			fmt.Fprintf(bw, `<polygon points="%s"%s/>`+"\n", strings.Join(points, " "), attrs)
+		}
+	}
+
+	bw.WriteString("</svg>\n")
+
+	return bw.Flush()
+}
+
+func (st Style) attrs() string {
+	var b strings.Builder
+
+	if st.Fill != "" {
+		fmt.Fprintf(&b, ` fill="%s"`, escape(st.Fill))
+	}
+	if st.Stroke != "" {
+		fmt.Fprintf(&b, ` stroke="%s" vector-effect="non-scaling-stroke"`, escape(st.Stroke))
+	}
+	if st.Opacity != 0 {
+		fmt.Fprintf(&b, ` opacity="%s"`, num(st.Opacity))
+	}
+
+	return b.String()
+}
+
+// num formats a coordinate with at most three decimals, which keeps the
+// output stable and free of float noise such as 0.8200000000000001.
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1000)/1000, 'f', -1, 64)
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
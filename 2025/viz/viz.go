@@ -0,0 +1,125 @@
+// Package viz animates grid simulations in the terminal. Solvers hand frames
+// to a Hook carried on their context; without one, drawing is skipped.
+package viz
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Grid is one frame of a simulation, one string per row.
+type Grid []string
+
+// Hook receives the frames of a simulation.
+type Hook func(title string, g Grid)
+
+type hookKey struct{}
+
+// WithHook returns a context that carries hook to the solvers run with it.
+func WithHook(ctx context.Context, hook Hook) context.Context {
+	return context.WithValue(ctx, hookKey{}, hook)
+}
+
+// From returns the hook carried by ctx, or nil. Solvers check for nil before
+// building a frame so that runs without -viz pay nothing for it.
+func From(ctx context.Context) Hook {
+	hook, _ := ctx.Value(hookKey{}).(Hook)
+	return hook
+}
+
+// Color is an ANSI foreground colour.
+type Color int
+
+const (
+	Red     Color = 31
+	Green   Color = 32
+	Yellow  Color = 33
+	Blue    Color = 34
+	Magenta Color = 35
+	Cyan    Color = 36
+	Gray    Color = 90
+)
+
+// DefaultPalette colours the cells used by the days that draw frames.
+var DefaultPalette = map[rune]Color{
+	'@': Yellow,
+	'x': Red,
+	'.': Gray,
+	'S': Green,
+	'^': Magenta,
+	'|': Cyan,
+}
+
+const (
+	clearScreen = "\x1b[2J"
+	cursorHome  = "\x1b[H"
+	clearLine   = "\x1b[K"
+	clearBelow  = "\x1b[J"
+	reset       = "\x1b[0m"
+)
+
+// Terminal draws frames over each other on an ANSI terminal, at most FPS
+// frames a second.
+type Terminal struct {
+	W       io.Writer
+	FPS     int
+	Palette map[rune]Color
+
+	last time.Time
+}
+
+// NewTerminal returns a Terminal writing to w with the default palette.
+func NewTerminal(w io.Writer, fps int) *Terminal {
+	return &Terminal{W: w, FPS: fps, Palette: DefaultPalette}
+}
+
+// Draw waits until the frame is due, then replaces the previous frame with g.
+// Pass it to WithHook to animate a run.
+func (t *Terminal) Draw(title string, g Grid) {
+	var b strings.Builder
+
+	if t.last.IsZero() {
+		b.WriteString(clearScreen)
+	}
+	b.WriteString(cursorHome)
+	b.WriteString(title + clearLine + "\n")
+	for _, row := range g {
+		b.WriteString(Colorize(row, t.Palette) + clearLine + "\n")
+	}
+	b.WriteString(clearBelow)
+
+	if t.FPS > 0 && !t.last.IsZero() {
+		time.Sleep(time.Until(t.last.Add(time.Second / time.Duration(t.FPS))))
+	}
+	t.last = time.Now()
+
+	fmt.Fprint(t.W, b.String())
+}
+
+// Colorize wraps each run of cells in the colour palette gives them. Cells
+// with no colour are written as they are.
+func Colorize(row string, palette map[rune]Color) string {
+	var b strings.Builder
+	current := Color(0)
+
+	for _, cell := range row {
+		if color := palette[cell]; color != current {
+			if color == 0 {
+				b.WriteString(reset)
+			} else {
+				fmt.Fprintf(&b, "\x1b[%dm", color)
+			}
+			current = color
+		}
+		b.WriteRune(cell)
+	}
+
+	if current != 0 {
+		b.WriteString(reset)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,70 @@
+package viz
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFrom(t *testing.T) {
+	if From(t.Context()) != nil {
+		t.Fatal("expected no hook on a plain context")
+	}
+
+	var titles []string
+	ctx := WithHook(t.Context(), func(title string, _ Grid) { titles = append(titles, title) })
+
+	From(ctx)("round 1", nil)
+	if len(titles) != 1 || titles[0] != "round 1" {
+		t.Errorf("got %q, want the frame passed to the hook", titles)
+	}
+}
+
+func TestColorize(t *testing.T) {
+	palette := map[rune]Color{'@': Yellow, '.': Gray}
+
+	tests := []struct {
+		row  string
+		want string
+	}{
+		{"", ""},
+		{"@@.", "\x1b[33m@@\x1b[90m.\x1b[0m"},
+		{"#@#", "#\x1b[33m@\x1b[0m#"},
+	}
+
+	for _, tt := range tests {
+		if got := Colorize(tt.row, palette); got != tt.want {
+			t.Errorf("Colorize(%q) = %q, want %q", tt.row, got, tt.want)
+		}
+	}
+}
+
+func TestTerminal_Draw(t *testing.T) {
+	var b strings.Builder
+	term := &Terminal{W: &b}
+
+	term.Draw("first", Grid{"ab"})
+	if got := b.String(); !strings.HasPrefix(got, clearScreen+cursorHome+"first") || !strings.Contains(got, "ab"+clearLine+"\n") {
+		t.Errorf("first frame should clear the screen and draw the grid, got %q", got)
+	}
+
+	b.Reset()
+	term.Draw("second", Grid{"cd"})
+	if got := b.String(); !strings.HasPrefix(got, cursorHome+"second") || !strings.HasSuffix(got, clearBelow) {
+		t.Errorf("later frames should redraw in place, got %q", got)
+	}
+}
+
+func TestTerminal_FrameRate(t *testing.T) {
+	var b strings.Builder
+	term := &Terminal{W: &b, FPS: 50}
+
+	start := time.Now()
+	for range 3 {
+		term.Draw("frame", nil)
+	}
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("drew 3 frames at 50 fps in %s, want at least 40ms", elapsed)
+	}
+}
@@ -0,0 +1,95 @@
+// Package watch polls files for changes. Polling keeps it portable and free
+// of external tools at the cost of a short delay before changes are noticed.
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Snapshot records the modification time and size of every watched file.
+type Snapshot map[string]fileState
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Take stats every file matching the glob patterns. Files that disappear
+// while the snapshot is taken are left out.
+func Take(patterns []string) (Snapshot, error) {
+	snapshot := Snapshot{}
+
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range matches {
+			info, err := os.Stat(path)
+			if err != nil || info.IsDir() {
+				continue
+			}
+
+			snapshot[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+
+	return snapshot, nil
+}
+
+// Changed returns the sorted paths that were added, removed or modified
+// between old and s.
+func (s Snapshot) Changed(old Snapshot) []string {
+	changed := []string{}
+
+	for path, state := range s {
+		if previous, ok := old[path]; !ok || !previous.modTime.Equal(state.modTime) || previous.size != state.size {
+			changed = append(changed, path)
+		}
+	}
+
+	for path := range old {
+		if _, ok := s[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	slices.Sort(changed)
+	return changed
+}
+
+// Poll takes a snapshot every interval and calls onChange with the changed
+// paths whenever it differs from the previous one. It returns nil once ctx is
+// done.
+func Poll(ctx context.Context, patterns []string, interval time.Duration, onChange func(changed []string)) error {
+	current, err := Take(patterns)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next, err := Take(patterns)
+		if err != nil {
+			return err
+		}
+
+		if changed := next.Changed(current); len(changed) > 0 {
+			onChange(changed)
+		}
+		current = next
+	}
+}
@@ -0,0 +1,102 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("failed to touch %s: %v", path, err)
+	}
+}
+
+func TestSnapshot_Changed(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Now().Add(-time.Hour)
+
+	kept := filepath.Join(dir, "kept.go")
+	edited := filepath.Join(dir, "edited.go")
+	removed := filepath.Join(dir, "removed.go")
+	added := filepath.Join(dir, "added.go")
+
+	writeFile(t, kept, "package a", base)
+	writeFile(t, edited, "package a", base)
+	writeFile(t, removed, "package a", base)
+
+	patterns := []string{filepath.Join(dir, "*.go")}
+	old, err := Take(patterns)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	writeFile(t, edited, "package b", base.Add(time.Second))
+	writeFile(t, added, "package a", base)
+	if err := os.Remove(removed); err != nil {
+		t.Fatalf("failed to remove: %v", err)
+	}
+
+	current, err := Take(patterns)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := current.Changed(old)
+	want := []string{added, edited, removed}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+
+	if got := current.Changed(current); len(got) != 0 {
+		t.Errorf("got %v want no changes", got)
+	}
+}
+
+func TestTake_BadPattern(t *testing.T) {
+	if _, err := Take([]string{"["}); err == nil {
+		t.Error("expected an error for a malformed pattern")
+	}
+}
+
+func TestPoll(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "input")
+	writeFile(t, path, "1", time.Now().Add(-time.Hour))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	changes := make(chan []string, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- Poll(ctx, []string{path}, 5*time.Millisecond, func(changed []string) {
+			changes <- changed
+			cancel()
+		})
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	writeFile(t, path, "12", time.Now())
+
+	select {
+	case got := <-changes:
+		if !slices.Equal(got, []string{path}) {
+			t.Errorf("got %v want [%s]", got, path)
+		}
+	case <-ctx.Done():
+		t.Fatal("change was never reported")
+	}
+
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}